@@ -0,0 +1,382 @@
+// Package alert evaluates declarative rules against polled pool and miner stats and notifies
+// when alerts start firing or get resolved.
+package alert
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/internal/poll"
+)
+
+// State is the state of an alert.
+type State string
+
+const (
+	Firing   State = "firing"
+	Resolved State = "resolved"
+)
+
+// Alert is emitted when a rule starts firing or gets resolved.
+// Value is the last evaluated value of the metric, for change rules the relative change.
+type Alert struct {
+	Rule        string    `json:"rule"`
+	Description string    `json:"description"`
+	Pool        string    `json:"pool"`
+	Miner       string    `json:"miner,omitempty"`
+	Metric      string    `json:"metric"`
+	State       State     `json:"state"`
+	Value       float64   `json:"value"`
+	StartsAt    time.Time `json:"startsAt"`
+	EndsAt      time.Time `json:"endsAt,omitempty"`
+	Silenced    bool      `json:"silenced"`
+}
+
+// Notifier delivers alerts.
+type Notifier interface {
+	Notify(ctx context.Context, a Alert) error
+}
+
+// NotifierFunc is a function that implements Notifier.
+type NotifierFunc func(ctx context.Context, a Alert) error
+
+// Notify implements Notifier.
+func (f NotifierFunc) Notify(ctx context.Context, a Alert) error {
+	return f(ctx, a)
+}
+
+// Silence mutes the notifications of matching alerts until it expires.
+// Empty fields match everything.
+type Silence struct {
+	Rule    string    `json:"rule,omitempty"`
+	Pool    string    `json:"pool,omitempty"`
+	Miner   string    `json:"miner,omitempty"`
+	Until   time.Time `json:"until"`
+	Comment string    `json:"comment,omitempty"`
+}
+
+func (s Silence) matches(r Rule) bool {
+	return (s.Rule == "" || s.Rule == r.Name) &&
+		(s.Pool == "" || s.Pool == r.Pool) &&
+		(s.Miner == "" || s.Miner == r.Miner)
+}
+
+// EngineOpts are options for the engine.
+type EngineOpts func(*Engine)
+
+// WithInterval sets the poll interval.
+func WithInterval(d time.Duration) EngineOpts {
+	return func(e *Engine) {
+		e.interval = d
+	}
+}
+
+// WithNotifier adds a notifier that receives all alerts that are not silenced.
+func WithNotifier(n Notifier) EngineOpts {
+	return func(e *Engine) {
+		e.notifiers = append(e.notifiers, n)
+	}
+}
+
+// WithErrorHandler sets a function that is called with errors of failed polls in Run.
+func WithErrorHandler(fn func(error)) EngineOpts {
+	return func(e *Engine) {
+		e.onError = fn
+	}
+}
+
+// Engine polls the data the rules need and tracks the state of every rule.
+type Engine struct {
+	client    *miningcore.Client
+	rules     []*compiled
+	interval  time.Duration
+	notifiers []Notifier
+	onError   func(error)
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    map[string]*ruleState
+	silences []Silence
+}
+
+type ruleState struct {
+	pendingSince time.Time
+	firing       bool
+	alert        Alert
+}
+
+// New creates a new engine. It returns an error if a rule is invalid or rule names are not unique.
+func New(c *miningcore.Client, rules []Rule, opts ...EngineOpts) (*Engine, error) {
+	e := &Engine{
+		client:   c,
+		interval: time.Minute,
+		now:      time.Now,
+		state:    make(map[string]*ruleState),
+	}
+	for _, r := range rules {
+		cr, err := compile(r)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := e.state[r.Name]; ok {
+			return nil, fmt.Errorf("duplicate rule %s", r.Name)
+		}
+		e.rules = append(e.rules, cr)
+		e.state[r.Name] = &ruleState{}
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e, nil
+}
+
+// Run evaluates the rules until the context is canceled.
+func (e *Engine) Run(ctx context.Context) error {
+	return poll.Run(ctx, e.interval, func(ctx context.Context) error {
+		_, err := e.Poll(ctx)
+		return err
+	}, e.onError)
+}
+
+// Poll fetches the data of all rules once, evaluates them and notifies about state changes.
+// It returns the alerts that changed state, including silenced ones. Rules whose data failed
+// to load keep their state, the first fetch or notifier error is returned.
+func (e *Engine) Poll(ctx context.Context) ([]Alert, error) {
+	fetched, firstErr := e.fetch(ctx)
+	now := e.now()
+
+	e.mu.Lock()
+	e.pruneSilences(now)
+	var changed []Alert
+	for _, r := range e.rules {
+		d, ok := fetched[targetOf(r)]
+		if !ok {
+			continue
+		}
+		value, active, ok := r.evaluate(d, now)
+		if !ok {
+			continue
+		}
+		if a, ok := e.transition(r, value, active, now); ok {
+			changed = append(changed, a)
+		}
+	}
+	e.mu.Unlock()
+
+	for _, a := range changed {
+		if a.Silenced {
+			continue
+		}
+		for _, n := range e.notifiers {
+			if err := n.Notify(ctx, a); err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return changed, firstErr
+}
+
+func (e *Engine) transition(r *compiled, value float64, active bool, now time.Time) (Alert, bool) {
+	s := e.state[r.Name]
+	s.alert.Value = value
+	if !active {
+		s.pendingSince = time.Time{}
+		if !s.firing {
+			return Alert{}, false
+		}
+		s.firing = false
+		s.alert.State = Resolved
+		s.alert.EndsAt = now
+		s.alert.Silenced = e.silenced(r.Rule)
+		return s.alert, true
+	}
+
+	if s.pendingSince.IsZero() {
+		s.pendingSince = now
+	}
+	if s.firing || now.Sub(s.pendingSince) < time.Duration(r.For) {
+		return Alert{}, false
+	}
+	s.firing = true
+	s.alert = Alert{
+		Rule:        r.Name,
+		Description: describe(r),
+		Pool:        r.Pool,
+		Miner:       r.Miner,
+		Metric:      r.Metric,
+		State:       Firing,
+		Value:       value,
+		StartsAt:    s.pendingSince,
+		Silenced:    e.silenced(r.Rule),
+	}
+	return s.alert, true
+}
+
+// Alerts returns all currently firing alerts.
+func (e *Engine) Alerts() []Alert {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var res []Alert
+	for _, r := range e.rules {
+		if s := e.state[r.Name]; s.firing {
+			a := s.alert
+			a.Silenced = e.silenced(r.Rule)
+			res = append(res, a)
+		}
+	}
+	return res
+}
+
+// Silence adds a silence.
+func (e *Engine) Silence(s Silence) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.silences = append(e.silences, s)
+}
+
+// Unsilence removes all silences for the given rule name.
+func (e *Engine) Unsilence(rule string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	kept := e.silences[:0]
+	for _, s := range e.silences {
+		if s.Rule != rule {
+			kept = append(kept, s)
+		}
+	}
+	e.silences = kept
+}
+
+// Silences returns the active silences.
+func (e *Engine) Silences() []Silence {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.pruneSilences(e.now())
+	return append([]Silence(nil), e.silences...)
+}
+
+func (e *Engine) silenced(r Rule) bool {
+	for _, s := range e.silences {
+		if s.matches(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Engine) pruneSilences(now time.Time) {
+	kept := e.silences[:0]
+	for _, s := range e.silences {
+		if s.Until.After(now) {
+			kept = append(kept, s)
+		}
+	}
+	e.silences = kept
+}
+
+type target struct {
+	pool  string
+	miner string
+}
+
+func targetOf(r *compiled) target {
+	return target{r.Pool, r.Miner}
+}
+
+// fetch loads every pool, pool performance and miner the rules need once.
+func (e *Engine) fetch(ctx context.Context) (map[target]*data, error) {
+	// the longest change window of a target decides the sample range to fetch
+	windows := make(map[target]Duration)
+	var targets []target
+	seen := make(map[target]bool)
+	for _, r := range e.rules {
+		t := targetOf(r)
+		if !seen[t] {
+			seen[t] = true
+			targets = append(targets, t)
+		}
+		if r.Change != "" && r.Window > windows[t] {
+			windows[t] = r.Window
+		}
+	}
+	perfWindows := make(map[string]Duration)
+	for t, w := range windows {
+		if t.miner == "" {
+			perfWindows[t.pool] = w
+		}
+	}
+
+	var firstErr error
+	fail := func(err error) {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	pools := make(map[string]*miningcore.PoolInfo)
+	res := make(map[target]*data)
+	for _, t := range targets {
+		pool, ok := pools[t.pool]
+		if !ok {
+			p, _, err := e.client.GetPool(ctx, t.pool)
+			if err != nil {
+				fail(err)
+			}
+			pools[t.pool] = p
+			pool = p
+		}
+		if pool == nil {
+			continue
+		}
+		d := &data{pool: pool}
+		if t.miner != "" {
+			miner, _, err := e.client.GetMiner(ctx, t.pool, t.miner, map[string]string{"perfMode": sampleRange(windows[t])})
+			if err != nil {
+				fail(err)
+				continue
+			}
+			d.miner = miner
+		} else if w, ok := perfWindows[t.pool]; ok {
+			perf, _, err := e.client.GetPerformance(ctx, t.pool, map[string]string{"r": sampleRange(w)})
+			if err != nil {
+				fail(err)
+				continue
+			}
+			d.perf = perf
+		}
+		res[t] = d
+	}
+	return res, firstErr
+}
+
+// sampleRange returns the performance sample range that reaches back over a change window.
+// The Day range covers less than a full day, so day long windows need the Month range.
+func sampleRange(window Duration) string {
+	if time.Duration(window) < 24*time.Hour {
+		return "Day"
+	}
+	return "Month"
+}
+
+func describe(r *compiled) string {
+	if r.Description != "" {
+		return r.Description
+	}
+	subject := "pool " + r.Pool
+	if r.Miner != "" {
+		subject = "miner " + r.Miner + " on pool " + r.Pool
+	}
+	var cond string
+	if r.Change != "" {
+		cond = fmt.Sprintf("changed by %s in %s", strings.TrimSpace(r.Change), time.Duration(r.Window))
+	} else {
+		cond = r.Op + " " + strings.TrimSpace(r.Value)
+	}
+	if r.For > 0 {
+		cond += fmt.Sprintf(" for %s", time.Duration(r.For))
+	}
+	return fmt.Sprintf("%s %s %s", subject, r.Metric, cond)
+}
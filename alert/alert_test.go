@@ -0,0 +1,216 @@
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+const rulesYAML = `
+rules:
+  - name: hashrate-low
+    pool: eth
+    metric: hashrate
+    op: "<"
+    value: 10 GH/s
+    for: 10m
+  - name: no-block
+    pool: eth
+    metric: sinceLastBlock
+    op: ">"
+    value: 6h
+  - name: difficulty-jump
+    pool: eth
+    metric: networkDifficulty
+    change: +20%
+    window: 1d
+  - name: miners-drop
+    pool: eth
+    metric: miners
+    change: -30%
+    window: 1h
+  - name: worker-count
+    pool: eth
+    miner: "0xabc"
+    metric: workers
+    op: "<"
+    value: 2
+`
+
+type poolServer struct {
+	mu    sync.Mutex
+	pool  miningcore.PoolInfo
+	perf  []*miningcore.PoolPerformance
+	miner miningcore.MinerStats
+}
+
+func (s *poolServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/performance"):
+		perf := s.perf
+		// like miningcore, the default range only reaches back a day
+		if r.URL.Query().Get("r") != "Month" && len(perf) > 0 {
+			last, _ := miningcore.ParseTime(perf[len(perf)-1].Created)
+			perf = nil
+			for _, p := range s.perf {
+				if t, _ := miningcore.ParseTime(p.Created); t.After(last.Add(-24 * time.Hour)) {
+					perf = append(perf, p)
+				}
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"stats": perf})
+	case strings.Contains(r.URL.Path, "/miners/"):
+		json.NewEncoder(w).Encode(s.miner)
+	default:
+		json.NewEncoder(w).Encode(map[string]any{"pool": s.pool})
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	rules, err := LoadRules(strings.NewReader(rulesYAML))
+	assert.NoError(t, err)
+	assert.Len(t, rules, 5)
+	assert.Equal(t, Duration(10*time.Minute), rules[0].For)
+	assert.Equal(t, Duration(24*time.Hour), rules[2].Window)
+	assert.Equal(t, "2", rules[4].Value)
+
+	rules, err = LoadRules(strings.NewReader(`{"rules": [{"name": "a", "pool": "eth", "metric": "peers", "op": "==", "value": "0", "for": "5m"}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, Duration(5*time.Minute), rules[0].For)
+
+	_, err = New(nil, []Rule{{Name: "a", Pool: "eth", Metric: "foo", Op: "<", Value: "1"}})
+	assert.ErrorContains(t, err, "unknown metric")
+	_, err = New(nil, []Rule{{Name: "a", Pool: "eth", Metric: "blockHeight", Change: "10%", Window: Duration(time.Hour)}})
+	assert.ErrorContains(t, err, "no history")
+	_, err = New(nil, []Rule{{Name: "a", Pool: "eth", Metric: "hashrate", Op: "<", Value: "10 XH/s"}})
+	assert.Error(t, err)
+	_, err = New(nil, []Rule{{Name: "a", Pool: "eth", Metric: "hashrate", Change: "10%", Window: Duration(31 * 24 * time.Hour)}})
+	assert.ErrorContains(t, err, "exceeds")
+}
+
+func TestChangeRuleBeyondADay(t *testing.T) {
+	now := time.Date(2022, 7, 2, 12, 0, 0, 0, time.UTC)
+	srv := &poolServer{
+		pool: miningcore.PoolInfo{
+			ID:        "eth",
+			PoolStats: &miningcore.PoolStats{PoolHashrate: 5e9},
+		},
+		perf: []*miningcore.PoolPerformance{
+			{PoolHashrate: 10e9, Created: now.Add(-48 * time.Hour).Format(time.RFC3339)},
+			{PoolHashrate: 8e9, Created: now.Add(-24 * time.Hour).Format(time.RFC3339)},
+			{PoolHashrate: 5e9, Created: now.Add(-time.Hour).Format(time.RFC3339)},
+		},
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	rules := []Rule{{Name: "halved", Pool: "eth", Metric: "hashrate", Change: "-50%", Window: Duration(48 * time.Hour)}}
+	e, err := New(miningcore.New(ts.URL), rules)
+	assert.NoError(t, err)
+	e.now = func() time.Time { return now }
+
+	changed, err := e.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, changed, 1)
+	assert.Equal(t, "halved", changed[0].Rule)
+	assert.InDelta(t, -0.5, changed[0].Value, 1e-9)
+}
+
+func TestEngine(t *testing.T) {
+	now := time.Date(2022, 7, 2, 12, 0, 0, 0, time.UTC)
+	srv := &poolServer{
+		pool: miningcore.PoolInfo{
+			ID:                "eth",
+			LastPoolBlockTime: now.Add(-time.Hour).Format(time.RFC3339),
+			PoolStats:         &miningcore.PoolStats{PoolHashrate: 5e9, ConnectedMiners: 100},
+			NetworkStats:      &miningcore.BlockchainStats{NetworkDifficulty: 1.25e16},
+		},
+		perf: []*miningcore.PoolPerformance{
+			{NetworkDifficulty: 1e16, ConnectedMiners: 100, Created: now.Add(-24 * time.Hour).Format(time.RFC3339)},
+			{NetworkDifficulty: 1.2e16, ConnectedMiners: 100, Created: now.Add(-time.Hour).Format(time.RFC3339)},
+		},
+		miner: miningcore.MinerStats{Performance: &miningcore.WorkerStats{Workers: map[string]*miningcore.WorkerPerformanceStats{
+			"rig1": {Hashrate: 1e8},
+			"rig2": {Hashrate: 1e8},
+		}}},
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	rules, err := LoadRules(strings.NewReader(rulesYAML))
+	assert.NoError(t, err)
+	var notified []Alert
+	e, err := New(miningcore.New(ts.URL), rules, WithNotifier(NotifierFunc(func(_ context.Context, a Alert) error {
+		notified = append(notified, a)
+		return nil
+	})))
+	assert.NoError(t, err)
+	e.now = func() time.Time { return now }
+
+	// the hashrate is low but not for 10m yet, the difficulty rose by 25% in a day
+	changed, err := e.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, changed, 1)
+	assert.Equal(t, "difficulty-jump", changed[0].Rule)
+	assert.Equal(t, Firing, changed[0].State)
+	assert.InDelta(t, 0.25, changed[0].Value, 1e-9)
+	assert.Equal(t, "pool eth networkDifficulty changed by +20% in 24h0m0s", changed[0].Description)
+
+	now = now.Add(10 * time.Minute)
+	e.Silence(Silence{Rule: "hashrate-low", Until: now.Add(time.Hour)})
+	srv.mu.Lock()
+	srv.pool.PoolStats.ConnectedMiners = 60
+	delete(srv.miner.Performance.Workers, "rig2")
+	srv.mu.Unlock()
+	changed, err = e.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, changed, 3)
+	assert.Equal(t, "hashrate-low", changed[0].Rule)
+	assert.True(t, changed[0].Silenced)
+	assert.Equal(t, now.Add(-10*time.Minute), changed[0].StartsAt)
+	assert.Equal(t, "miners-drop", changed[1].Rule)
+	assert.Equal(t, "worker-count", changed[2].Rule)
+	assert.Equal(t, "miner 0xabc on pool eth workers < 2", changed[2].Description)
+	assert.Len(t, notified, 3)
+	assert.Len(t, e.Alerts(), 4)
+
+	now = now.Add(6 * time.Hour)
+	srv.mu.Lock()
+	srv.pool.PoolStats.PoolHashrate = 20e9
+	srv.mu.Unlock()
+	changed, err = e.Poll(context.Background())
+	assert.NoError(t, err)
+	var states []string
+	for _, a := range changed {
+		states = append(states, a.Rule+"/"+string(a.State))
+	}
+	assert.Equal(t, []string{"hashrate-low/resolved", "no-block/firing"}, states)
+	assert.Equal(t, now, changed[0].EndsAt)
+	assert.False(t, changed[0].Silenced)
+	assert.Empty(t, e.Silences())
+}
+
+func TestClosest(t *testing.T) {
+	now := time.Date(2022, 7, 2, 12, 0, 0, 0, time.UTC)
+	points := []point{{time: now.Add(-2 * time.Hour), value: 1}, {time: now.Add(-time.Hour), value: 2}}
+
+	v, ok := closest(points, now.Add(-90*time.Minute+time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, float64(2), v)
+
+	// the history starts after the start of the window
+	_, ok = closest(points, now.Add(-3*time.Hour))
+	assert.False(t, ok)
+	_, ok = closest(nil, now)
+	assert.False(t, ok)
+}
@@ -0,0 +1,420 @@
+package alert
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"gopkg.in/yaml.v3"
+)
+
+// Rule is a declarative alert condition on a pool or, if Miner is set, on a miner of the pool.
+//
+// Threshold rules compare the current value of the metric against Value using Op, e.g.
+// hashrate < 10 GH/s or sinceLastBlock > 6h. Change rules compare the current value against
+// the value Window ago, a Change of +20% fires on an increase of at least 20%, -30% on a
+// decrease of at least 30%. Window can be at most 30d, the history miningcore keeps.
+// A rule fires once its condition held for the For duration.
+type Rule struct {
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Pool        string   `json:"pool" yaml:"pool"`
+	Miner       string   `json:"miner,omitempty" yaml:"miner,omitempty"`
+	Metric      string   `json:"metric" yaml:"metric"`
+	Op          string   `json:"op,omitempty" yaml:"op,omitempty"`
+	Value       string   `json:"value,omitempty" yaml:"value,omitempty"`
+	Change      string   `json:"change,omitempty" yaml:"change,omitempty"`
+	Window      Duration `json:"window,omitempty" yaml:"window,omitempty"`
+	For         Duration `json:"for,omitempty" yaml:"for,omitempty"`
+}
+
+// Duration is a time.Duration that is read from strings like 10m, 6h or 1d.
+type Duration time.Duration
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Duration) UnmarshalText(b []byte) error {
+	v, err := parseDuration(string(b))
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// parseDuration extends time.ParseDuration with a d unit for days.
+func parseDuration(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasSuffix(s, "d") {
+		n, err := strconv.ParseFloat(strings.TrimSuffix(s, "d"), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(n * float64(24*time.Hour)), nil
+	}
+	return time.ParseDuration(s)
+}
+
+// LoadRules reads rules from YAML or JSON of the form {"rules": [...]}.
+func LoadRules(r io.Reader) ([]Rule, error) {
+	var f struct {
+		Rules []Rule `yaml:"rules"`
+	}
+	if err := yaml.NewDecoder(r).Decode(&f); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return f.Rules, nil
+}
+
+// LoadRulesFile reads rules from a YAML or JSON file.
+func LoadRulesFile(path string) ([]Rule, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return LoadRules(f)
+}
+
+type valueKind int
+
+const (
+	numberValue valueKind = iota
+	hashrateValue
+	durationValue
+)
+
+// point is a historic value of a metric.
+type point struct {
+	time  time.Time
+	value float64
+}
+
+// data is the polled state a rule is evaluated against.
+type data struct {
+	pool  *miningcore.PoolInfo
+	perf  []*miningcore.PoolPerformance
+	miner *miningcore.MinerStats
+}
+
+type metric struct {
+	kind    valueKind
+	current func(d *data, now time.Time) (float64, bool)
+	history func(d *data) []point
+}
+
+var poolMetrics = map[string]metric{
+	"hashrate": {
+		kind: hashrateValue,
+		current: poolStat(func(s *miningcore.PoolStats) float64 {
+			return float64(s.PoolHashrate)
+		}),
+		history: poolHistory(func(p *miningcore.PoolPerformance) float64 { return float64(p.PoolHashrate) }),
+	},
+	"miners": {
+		current: poolStat(func(s *miningcore.PoolStats) float64 {
+			return float64(s.ConnectedMiners)
+		}),
+		history: poolHistory(func(p *miningcore.PoolPerformance) float64 { return float64(p.ConnectedMiners) }),
+	},
+	"sharesPerSecond": {
+		current: poolStat(func(s *miningcore.PoolStats) float64 {
+			return float64(s.SharesPerSecond)
+		}),
+		history: poolHistory(func(p *miningcore.PoolPerformance) float64 { return float64(p.ValidSharesPerSecond) }),
+	},
+	"networkHashrate": {
+		kind: hashrateValue,
+		current: networkStat(func(s *miningcore.BlockchainStats) float64 {
+			return float64(s.NetworkHashrate)
+		}),
+		history: poolHistory(func(p *miningcore.PoolPerformance) float64 { return float64(p.NetworkHashrate) }),
+	},
+	"networkDifficulty": {
+		current: networkStat(func(s *miningcore.BlockchainStats) float64 {
+			return float64(s.NetworkDifficulty)
+		}),
+		history: poolHistory(func(p *miningcore.PoolPerformance) float64 { return float64(p.NetworkDifficulty) }),
+	},
+	"blockHeight": {
+		current: networkStat(func(s *miningcore.BlockchainStats) float64 {
+			return float64(s.BlockHeight)
+		}),
+	},
+	"peers": {
+		current: networkStat(func(s *miningcore.BlockchainStats) float64 {
+			return float64(s.ConnectedPeers)
+		}),
+	},
+	"effort": {
+		current: func(d *data, _ time.Time) (float64, bool) {
+			return d.pool.PoolEffort, true
+		},
+	},
+	"sinceLastBlock": {
+		kind: durationValue,
+		current: func(d *data, now time.Time) (float64, bool) {
+			return since(d.pool.LastPoolBlockTime, now)
+		},
+	},
+	"sinceLastNetworkBlock": {
+		kind: durationValue,
+		current: func(d *data, now time.Time) (float64, bool) {
+			if d.pool.NetworkStats == nil {
+				return 0, false
+			}
+			return since(d.pool.NetworkStats.LastNetworkBlockTime, now)
+		},
+	},
+}
+
+var minerMetrics = map[string]metric{
+	"hashrate": {
+		kind: hashrateValue,
+		current: minerStat(func(w *miningcore.WorkerStats) float64 {
+			return workerSum(w, func(s *miningcore.WorkerPerformanceStats) float64 { return float64(s.Hashrate) })
+		}),
+		history: minerHistory(func(w *miningcore.WorkerStats) float64 {
+			return workerSum(w, func(s *miningcore.WorkerPerformanceStats) float64 { return float64(s.Hashrate) })
+		}),
+	},
+	"sharesPerSecond": {
+		current: minerStat(func(w *miningcore.WorkerStats) float64 {
+			return workerSum(w, func(s *miningcore.WorkerPerformanceStats) float64 { return s.SharesPerSecond })
+		}),
+		history: minerHistory(func(w *miningcore.WorkerStats) float64 {
+			return workerSum(w, func(s *miningcore.WorkerPerformanceStats) float64 { return s.SharesPerSecond })
+		}),
+	},
+	"workers": {
+		current: minerStat(func(w *miningcore.WorkerStats) float64 {
+			return float64(len(w.Workers))
+		}),
+		history: minerHistory(func(w *miningcore.WorkerStats) float64 { return float64(len(w.Workers)) }),
+	},
+	"pendingBalance": {
+		current: func(d *data, _ time.Time) (float64, bool) {
+			return d.miner.PendingBalance, true
+		},
+	},
+	"sinceLastPayment": {
+		kind: durationValue,
+		current: func(d *data, now time.Time) (float64, bool) {
+			return since(d.miner.LastPayment, now)
+		},
+	},
+}
+
+func poolStat(fn func(*miningcore.PoolStats) float64) func(*data, time.Time) (float64, bool) {
+	return func(d *data, _ time.Time) (float64, bool) {
+		if d.pool.PoolStats == nil {
+			return 0, false
+		}
+		return fn(d.pool.PoolStats), true
+	}
+}
+
+func networkStat(fn func(*miningcore.BlockchainStats) float64) func(*data, time.Time) (float64, bool) {
+	return func(d *data, _ time.Time) (float64, bool) {
+		if d.pool.NetworkStats == nil {
+			return 0, false
+		}
+		return fn(d.pool.NetworkStats), true
+	}
+}
+
+func minerStat(fn func(*miningcore.WorkerStats) float64) func(*data, time.Time) (float64, bool) {
+	return func(d *data, _ time.Time) (float64, bool) {
+		// a miner without current performance has no active workers
+		if d.miner.Performance == nil {
+			return 0, true
+		}
+		return fn(d.miner.Performance), true
+	}
+}
+
+func poolHistory(fn func(*miningcore.PoolPerformance) float64) func(*data) []point {
+	return func(d *data) []point {
+		var res []point
+		for _, p := range d.perf {
+			if t, err := miningcore.ParseTime(p.Created); err == nil {
+				res = append(res, point{t, fn(p)})
+			}
+		}
+		return res
+	}
+}
+
+func minerHistory(fn func(*miningcore.WorkerStats) float64) func(*data) []point {
+	return func(d *data) []point {
+		var res []point
+		for _, w := range d.miner.PerformanceSamples {
+			if t, err := miningcore.ParseTime(w.Created); err == nil {
+				res = append(res, point{t, fn(w)})
+			}
+		}
+		return res
+	}
+}
+
+func workerSum(w *miningcore.WorkerStats, fn func(*miningcore.WorkerPerformanceStats) float64) float64 {
+	var sum float64
+	for _, s := range w.Workers {
+		sum += fn(s)
+	}
+	return sum
+}
+
+// since returns the seconds elapsed since t, times that are not set yield no value.
+func since(t string, now time.Time) (float64, bool) {
+	if t == "" {
+		return 0, false
+	}
+	parsed, err := miningcore.ParseTime(t)
+	if err != nil {
+		return 0, false
+	}
+	return now.Sub(parsed).Seconds(), true
+}
+
+var ops = map[string]func(a, b float64) bool{
+	"<":  func(a, b float64) bool { return a < b },
+	"<=": func(a, b float64) bool { return a <= b },
+	">":  func(a, b float64) bool { return a > b },
+	">=": func(a, b float64) bool { return a >= b },
+	"==": func(a, b float64) bool { return a == b },
+	"!=": func(a, b float64) bool { return a != b },
+}
+
+// maxWindow is the longest change window, the Month sample range of miningcore spans 30 days.
+const maxWindow = 30 * 24 * time.Hour
+
+// compiled is a validated rule with its parsed values.
+type compiled struct {
+	Rule
+	metric    metric
+	op        func(a, b float64) bool
+	threshold float64
+	change    float64
+}
+
+func compile(r Rule) (*compiled, error) {
+	if r.Name == "" {
+		return nil, errors.New("rule without name")
+	}
+	if r.Pool == "" {
+		return nil, fmt.Errorf("rule %s: pool is required", r.Name)
+	}
+	metrics := poolMetrics
+	if r.Miner != "" {
+		metrics = minerMetrics
+	}
+	m, ok := metrics[r.Metric]
+	if !ok {
+		return nil, fmt.Errorf("rule %s: unknown metric %q", r.Name, r.Metric)
+	}
+	c := &compiled{Rule: r, metric: m}
+
+	switch {
+	case r.Change != "" && r.Op != "":
+		return nil, fmt.Errorf("rule %s: op and change are mutually exclusive", r.Name)
+	case r.Change != "":
+		if m.history == nil {
+			return nil, fmt.Errorf("rule %s: metric %s has no history for change rules", r.Name, r.Metric)
+		}
+		if r.Window <= 0 {
+			return nil, fmt.Errorf("rule %s: change rules require a window", r.Name)
+		}
+		if time.Duration(r.Window) > maxWindow {
+			return nil, fmt.Errorf("rule %s: window %s exceeds the %s of history miningcore keeps", r.Name, time.Duration(r.Window), maxWindow)
+		}
+		change, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(r.Change), "%"), 64)
+		if err != nil || change == 0 {
+			return nil, fmt.Errorf("rule %s: invalid change %q", r.Name, r.Change)
+		}
+		c.change = change / 100
+	case r.Op != "":
+		if c.op, ok = ops[r.Op]; !ok {
+			return nil, fmt.Errorf("rule %s: unknown op %q", r.Name, r.Op)
+		}
+		v, err := parseValue(m.kind, r.Value)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", r.Name, err)
+		}
+		c.threshold = v
+	default:
+		return nil, fmt.Errorf("rule %s: either op or change is required", r.Name)
+	}
+	return c, nil
+}
+
+func parseValue(kind valueKind, s string) (float64, error) {
+	switch kind {
+	case hashrateValue:
+		h, err := miningcore.ParseHashrate(s)
+		return float64(h), err
+	case durationValue:
+		d, err := parseDuration(s)
+		return d.Seconds(), err
+	}
+	v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	return v, nil
+}
+
+// evaluate reports whether the condition of the rule holds and the value it was checked with.
+// For change rules the value is the relative change.
+func (c *compiled) evaluate(d *data, now time.Time) (value float64, active, ok bool) {
+	cur, ok := c.metric.current(d, now)
+	if !ok {
+		return 0, false, false
+	}
+	if c.op != nil {
+		return cur, c.op(cur, c.threshold), true
+	}
+
+	ref, ok := closest(c.metric.history(d), now.Add(-time.Duration(c.Window)))
+	if !ok || ref == 0 {
+		return 0, false, false
+	}
+	rel := (cur - ref) / ref
+	if c.change > 0 {
+		return rel, rel >= c.change, true
+	}
+	return rel, rel <= c.change, true
+}
+
+// closest returns the value of the point closest to t.
+// It fails if no point is at or before t, the history doesn't cover the window yet.
+func closest(points []point, t time.Time) (float64, bool) {
+	var best *point
+	var bestDist time.Duration
+	covered := false
+	for i := range points {
+		if !points[i].time.After(t) {
+			covered = true
+		}
+		dist := points[i].time.Sub(t)
+		if dist < 0 {
+			dist = -dist
+		}
+		if best == nil || dist < bestDist {
+			best, bestDist = &points[i], dist
+		}
+	}
+	if !covered {
+		return 0, false
+	}
+	return best.value, true
+}
@@ -0,0 +1,270 @@
+// Package analytics computes luck and effort statistics from the block history
+// and performance samples of a miningcore pool.
+package analytics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Distribution summarizes a set of durations.
+type Distribution struct {
+	Count  int           `json:"count"`
+	Min    time.Duration `json:"min"`
+	Max    time.Duration `json:"max"`
+	Mean   time.Duration `json:"mean"`
+	Median time.Duration `json:"median"`
+	P90    time.Duration `json:"p90"`
+}
+
+// Stats are the block statistics of a pool for a time range.
+// Luck is the ratio of found to expected blocks, values above 1 mean the pool was lucky.
+// Effort values are fractions as reported by miningcore, 1 equals 100%.
+type Stats struct {
+	Start             time.Time    `json:"start"`
+	End               time.Time    `json:"end"`
+	Blocks            int          `json:"blocks"`
+	Confirmed         int          `json:"confirmed"`
+	Orphaned          int          `json:"orphaned"`
+	Pending           int          `json:"pending"`
+	AverageEffort     float64      `json:"averageEffort"`
+	MedianEffort      float64      `json:"medianEffort"`
+	OrphanRate        float64      `json:"orphanRate"`
+	ExpectedBlocks    float64      `json:"expectedBlocks"`
+	Luck              float64      `json:"luck"`
+	TimeBetweenBlocks Distribution `json:"timeBetweenBlocks"`
+}
+
+// Analyze computes the statistics of all blocks and performance samples between start and end.
+// The coin family is used to convert the network difficulty into expected hashes.
+func Analyze(blocks []*miningcore.Block, perf []*miningcore.PoolPerformance, family miningcore.CoinFamily, start, end time.Time) (*Stats, error) {
+	samples, err := parseSamples(perf)
+	if err != nil {
+		return nil, err
+	}
+	found, err := parseBlocks(blocks)
+	if err != nil {
+		return nil, err
+	}
+	return analyze(found, samples, family, start, end), nil
+}
+
+// AnalyzeByPeriod splits the time range covered by blocks and performance samples into periods
+// of the given length and computes the statistics for each of them.
+func AnalyzeByPeriod(blocks []*miningcore.Block, perf []*miningcore.PoolPerformance, family miningcore.CoinFamily, period time.Duration) ([]*Stats, error) {
+	samples, err := parseSamples(perf)
+	if err != nil {
+		return nil, err
+	}
+	found, err := parseBlocks(blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	var first, last time.Time
+	for _, b := range found {
+		first, last = extend(first, last, b.created)
+	}
+	for _, s := range samples {
+		first, last = extend(first, last, s.created)
+	}
+	if first.IsZero() {
+		return nil, nil
+	}
+
+	var res []*Stats
+	for start := first.Truncate(period); !start.After(last); start = start.Add(period) {
+		res = append(res, analyze(found, samples, family, start, start.Add(period)))
+	}
+	return res, nil
+}
+
+// ExpectedBlocks returns the number of blocks the pool should have found between start and end
+// based on its hashrate and the network difficulty of the performance samples.
+func ExpectedBlocks(perf []*miningcore.PoolPerformance, family miningcore.CoinFamily, start, end time.Time) (float64, error) {
+	samples, err := parseSamples(perf)
+	if err != nil {
+		return 0, err
+	}
+	return expectedBlocks(samples, family, start, end), nil
+}
+
+// FetchBlocks pages through the block history of a pool and returns all blocks created after since.
+func FetchBlocks(ctx context.Context, c *miningcore.Client, id string, since time.Time) ([]*miningcore.Block, error) {
+	return miningcore.Collect(ctx, c.PoolBlocksPages(id), since, time.Time{}, func(b *miningcore.Block) string { return b.Created })
+}
+
+type block struct {
+	*miningcore.Block
+	created time.Time
+}
+
+type sample struct {
+	*miningcore.PoolPerformance
+	created  time.Time
+	interval time.Duration
+}
+
+func parseBlocks(blocks []*miningcore.Block) ([]block, error) {
+	res := make([]block, 0, len(blocks))
+	for _, b := range blocks {
+		created, err := miningcore.ParseTime(b.Created)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, block{Block: b, created: created})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].created.Before(res[j].created) })
+	return res, nil
+}
+
+// parseSamples sorts the samples by time. Each sample is valid until the next one,
+// the last sample is assumed to cover the same interval as the one before.
+func parseSamples(perf []*miningcore.PoolPerformance) ([]sample, error) {
+	res := make([]sample, 0, len(perf))
+	for _, p := range perf {
+		created, err := miningcore.ParseTime(p.Created)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, sample{PoolPerformance: p, created: created})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].created.Before(res[j].created) })
+	for i := range res {
+		switch {
+		case i+1 < len(res):
+			res[i].interval = res[i+1].created.Sub(res[i].created)
+		case i > 0:
+			res[i].interval = res[i-1].interval
+		default:
+			res[i].interval = time.Hour
+		}
+	}
+	return res, nil
+}
+
+func analyze(blocks []block, samples []sample, family miningcore.CoinFamily, start, end time.Time) *Stats {
+	s := &Stats{Start: start, End: end}
+	var efforts []float64
+	var gaps []time.Duration
+	var prev time.Time
+	for _, b := range blocks {
+		if b.created.Before(start) || !b.created.Before(end) {
+			continue
+		}
+		s.Blocks++
+		switch b.Status {
+		case miningcore.BlockConfirmed:
+			s.Confirmed++
+		case miningcore.BlockOrphaned:
+			s.Orphaned++
+		default:
+			s.Pending++
+		}
+		if b.Effort > 0 {
+			efforts = append(efforts, b.Effort)
+		}
+		if !prev.IsZero() {
+			gaps = append(gaps, b.created.Sub(prev))
+		}
+		prev = b.created
+	}
+
+	s.AverageEffort = mean(efforts)
+	s.MedianEffort = median(efforts)
+	if settled := s.Confirmed + s.Orphaned; settled > 0 {
+		s.OrphanRate = float64(s.Orphaned) / float64(settled)
+	}
+	s.ExpectedBlocks = expectedBlocks(samples, family, start, end)
+	if s.ExpectedBlocks > 0 {
+		s.Luck = float64(s.Blocks) / s.ExpectedBlocks
+	}
+	s.TimeBetweenBlocks = Summarize(gaps)
+	return s
+}
+
+func expectedBlocks(samples []sample, family miningcore.CoinFamily, start, end time.Time) float64 {
+	var expected float64
+	for _, s := range samples {
+		from, to := s.created, s.created.Add(s.interval)
+		if from.Before(start) {
+			from = start
+		}
+		if to.After(end) {
+			to = end
+		}
+		if !to.After(from) {
+			continue
+		}
+		hashes := s.NetworkDifficulty.ExpectedHashes(family)
+		if hashes <= 0 {
+			continue
+		}
+		expected += float64(s.PoolHashrate) * to.Sub(from).Seconds() / hashes
+	}
+	return expected
+}
+
+// Summarize returns the distribution of a set of durations. The median of an even count
+// is the mean of the two middle values.
+func Summarize(d []time.Duration) Distribution {
+	if len(d) == 0 {
+		return Distribution{}
+	}
+	sorted := append([]time.Duration(nil), d...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	var sum time.Duration
+	for _, v := range sorted {
+		sum += v
+	}
+	mid := len(sorted) / 2
+	med := sorted[mid]
+	if len(sorted)%2 == 0 {
+		med = (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return Distribution{
+		Count:  len(sorted),
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+		Mean:   sum / time.Duration(len(sorted)),
+		Median: med,
+		P90:    sorted[(len(sorted)*9-1)/10],
+	}
+}
+
+func mean(v []float64) float64 {
+	if len(v) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, f := range v {
+		sum += f
+	}
+	return sum / float64(len(v))
+}
+
+func median(v []float64) float64 {
+	if len(v) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), v...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+func extend(first, last, t time.Time) (time.Time, time.Time) {
+	if first.IsZero() || t.Before(first) {
+		first = t
+	}
+	if t.After(last) {
+		last = t
+	}
+	return first, last
+}
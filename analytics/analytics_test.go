@@ -0,0 +1,131 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func testData() ([]*miningcore.Block, []*miningcore.PoolPerformance) {
+	blocks := []*miningcore.Block{
+		{Status: miningcore.BlockConfirmed, Effort: 0.5, Created: "2022-07-01T01:00:00Z"},
+		{Status: miningcore.BlockOrphaned, Effort: 1.5, Created: "2022-07-01T03:00:00Z"},
+		{Status: miningcore.BlockConfirmed, Effort: 1, Created: "2022-07-01T07:00:00Z"},
+		{Status: miningcore.BlockPending, Effort: 2, Created: "2022-07-02T01:00:00Z"},
+	}
+	var perf []*miningcore.PoolPerformance
+	for h := 0; h < 48; h++ {
+		perf = append(perf, &miningcore.PoolPerformance{
+			PoolHashrate:      1000,
+			NetworkDifficulty: 3600 * 1000 * 8, // one block every 8 hours
+			Created:           time.Date(2022, 7, 1, h, 0, 0, 0, time.UTC).Format(time.RFC3339),
+		})
+	}
+	return blocks, perf
+}
+
+func TestAnalyze(t *testing.T) {
+	blocks, perf := testData()
+	start := time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)
+	s, err := Analyze(blocks, perf, miningcore.FamilyEthereum, start, start.Add(48*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, s.Blocks)
+	assert.Equal(t, 2, s.Confirmed)
+	assert.Equal(t, 1, s.Orphaned)
+	assert.Equal(t, 1, s.Pending)
+	assert.InDelta(t, 1.25, s.AverageEffort, 1e-9)
+	assert.InDelta(t, 1.25, s.MedianEffort, 1e-9)
+	assert.InDelta(t, 1.0/3, s.OrphanRate, 1e-9)
+	assert.InDelta(t, 6, s.ExpectedBlocks, 1e-9)
+	assert.InDelta(t, 4.0/6, s.Luck, 1e-9)
+	assert.Equal(t, 3, s.TimeBetweenBlocks.Count)
+	assert.Equal(t, 2*time.Hour, s.TimeBetweenBlocks.Min)
+	assert.Equal(t, 4*time.Hour, s.TimeBetweenBlocks.Median)
+	assert.Equal(t, 18*time.Hour, s.TimeBetweenBlocks.Max)
+}
+
+func TestAnalyzeByPeriod(t *testing.T) {
+	blocks, perf := testData()
+	periods, err := AnalyzeByPeriod(blocks, perf, miningcore.FamilyEthereum, 24*time.Hour)
+	assert.NoError(t, err)
+	assert.Len(t, periods, 2)
+	assert.Equal(t, 3, periods[0].Blocks)
+	assert.InDelta(t, 1, periods[0].Luck, 1e-9)
+	assert.Equal(t, 1, periods[1].Blocks)
+	assert.InDelta(t, 3, periods[1].ExpectedBlocks, 1e-9)
+}
+
+func TestEstimateEarnings(t *testing.T) {
+	pool := &miningcore.PoolInfo{
+		Coin:              &miningcore.APICoinConfig{Family: miningcore.FamilyEthereum},
+		PoolFeePercent:    1,
+		PaymentProcessing: &miningcore.APIPoolPaymentProcessingConfig{PayoutScheme: miningcore.PayoutPPLNS, MinimumPayment: 0.1},
+		NetworkStats:      &miningcore.BlockchainStats{NetworkDifficulty: 86400 * 100},
+	}
+	blocks := []*miningcore.Block{
+		{Status: miningcore.BlockConfirmed, Reward: 2},
+		{Status: miningcore.BlockConfirmed, Reward: 4},
+		{Status: miningcore.BlockOrphaned, Reward: 100},
+	}
+	miner := &miningcore.MinerStats{
+		PendingBalance: 0.5,
+		Performance: &miningcore.WorkerStats{Workers: map[string]*miningcore.WorkerPerformanceStats{
+			"rig1": {Hashrate: 4},
+			"rig2": {Hashrate: 6},
+		}},
+	}
+
+	e, err := EstimateEarnings(pool, blocks, miner, &miningcore.MinerSettings{PaymentThreshold: 1.094})
+	assert.NoError(t, err)
+	assert.Equal(t, miningcore.Hashrate(10), e.Hashrate)
+	assert.Equal(t, 3.0, e.BlockReward)
+	assert.InDelta(t, 0.1, e.BlocksPerDay, 1e-9)
+	assert.InDelta(t, 0.297, e.Daily, 1e-9)
+	assert.InDelta(t, 0.297*7, e.Weekly, 1e-9)
+	assert.InDelta(t, float64(2*24*time.Hour), float64(e.TimeToPayout), float64(time.Second))
+
+	e, err = EstimateEarnings(pool, blocks, miner, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0.1, e.Threshold)
+	assert.Equal(t, time.Duration(0), e.TimeToPayout)
+
+	_, err = EstimateEarnings(pool, blocks[2:], miner, nil)
+	assert.ErrorIs(t, err, ErrNoBlockReward)
+}
+
+func TestEstimateMinerSettings(t *testing.T) {
+	settingsStatus := http.StatusNotFound
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/pools/eth":
+			json.NewEncoder(w).Encode(map[string]interface{}{"pool": miningcore.PoolInfo{
+				ID:                "eth",
+				Coin:              &miningcore.APICoinConfig{Family: miningcore.FamilyEthereum},
+				PaymentProcessing: &miningcore.APIPoolPaymentProcessingConfig{MinimumPayment: 0.1},
+				NetworkStats:      &miningcore.BlockchainStats{NetworkDifficulty: 1000},
+			}})
+		case "/api/v2/pools/eth/blocks":
+			json.NewEncoder(w).Encode(miningcore.BlocksRes{Result: []*miningcore.Block{{Status: miningcore.BlockConfirmed, Reward: 2}}})
+		case "/api/pools/eth/miners/0xabc":
+			json.NewEncoder(w).Encode(miningcore.MinerStats{})
+		case "/api/pools/eth/miners/0xabc/settings":
+			w.WriteHeader(settingsStatus)
+		}
+	}))
+	defer ts.Close()
+	c := miningcore.New(ts.URL)
+
+	e, err := EstimateMiner(context.Background(), c, "eth", "0xabc")
+	assert.NoError(t, err)
+	assert.Equal(t, 0.1, e.Threshold)
+
+	settingsStatus = http.StatusInternalServerError
+	_, err = EstimateMiner(context.Background(), c, "eth", "0xabc")
+	assert.Error(t, err)
+}
@@ -0,0 +1,138 @@
+package analytics
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+var (
+	// ErrNoBlockReward is returned if the block history contains no confirmed block with a reward.
+	ErrNoBlockReward = errors.New("no block reward available")
+	// ErrNoNetworkStats is returned if the pool does not report its network difficulty.
+	ErrNoNetworkStats = errors.New("no network stats available")
+)
+
+// Estimate is the projected income of a miner.
+// The values are long term averages, actual earnings vary with luck and even more so on SOLO pools.
+// TimeToPayout is negative if the miner is not expected to ever reach the payout threshold.
+type Estimate struct {
+	Hashrate       miningcore.Hashrate     `json:"hashrate"`
+	PayoutScheme   miningcore.PayoutScheme `json:"payoutScheme"`
+	BlockReward    float64                 `json:"blockReward"`
+	BlocksPerDay   float64                 `json:"blocksPerDay"`
+	Daily          float64                 `json:"daily"`
+	Weekly         float64                 `json:"weekly"`
+	Monthly        float64                 `json:"monthly"`
+	PendingBalance float64                 `json:"pendingBalance"`
+	Threshold      float64                 `json:"threshold"`
+	TimeToPayout   time.Duration           `json:"timeToPayout"`
+}
+
+// EstimateEarnings projects the earnings of a miner from the pool config, the recent block rewards
+// and the current hashrate of the miner. If settings is nil or has no payment threshold,
+// the minimum payment of the pool is used.
+func EstimateEarnings(pool *miningcore.PoolInfo, blocks []*miningcore.Block, miner *miningcore.MinerStats, settings *miningcore.MinerSettings) (*Estimate, error) {
+	if pool.NetworkStats == nil || pool.NetworkStats.NetworkDifficulty <= 0 {
+		return nil, ErrNoNetworkStats
+	}
+	reward := averageReward(blocks)
+	if reward <= 0 {
+		return nil, ErrNoBlockReward
+	}
+
+	var family miningcore.CoinFamily
+	if pool.Coin != nil {
+		family = pool.Coin.Family
+	}
+	e := &Estimate{
+		Hashrate:       MinerHashrate(miner),
+		BlockReward:    reward,
+		PendingBalance: miner.PendingBalance,
+	}
+	if pool.PaymentProcessing != nil {
+		e.PayoutScheme = pool.PaymentProcessing.PayoutScheme
+		e.Threshold = pool.PaymentProcessing.MinimumPayment
+	}
+	if settings != nil && settings.PaymentThreshold > 0 {
+		e.Threshold = settings.PaymentThreshold
+	}
+
+	hashes := pool.NetworkStats.NetworkDifficulty.ExpectedHashes(family)
+	e.BlocksPerDay = float64(e.Hashrate) * (24 * time.Hour).Seconds() / hashes
+	e.Daily = e.BlocksPerDay * reward * (1 - pool.PoolFeePercent/100)
+	e.Weekly = e.Daily * 7
+	e.Monthly = e.Daily * 30
+	e.TimeToPayout = timeToPayout(e.Daily, e.Threshold-e.PendingBalance)
+	return e, nil
+}
+
+// EstimateMiner fetches everything needed for EstimateEarnings and returns the estimate.
+func EstimateMiner(ctx context.Context, c *miningcore.Client, id, addr string) (*Estimate, error) {
+	pool, _, err := c.GetPool(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	blocks, _, err := c.GetPoolBlocks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	miner, _, err := c.GetMiner(ctx, id, addr)
+	if err != nil {
+		return nil, err
+	}
+	// miners without custom settings fall back to the pool minimum payment
+	settings, status, err := c.GetMinerSettings(ctx, id, addr)
+	if err != nil {
+		if status != http.StatusNotFound {
+			return nil, err
+		}
+		settings = nil
+	}
+	return EstimateEarnings(pool, blocks.Result, miner, settings)
+}
+
+// MinerHashrate returns the current hashrate of a miner summed over all workers.
+// If no current performance is reported, the latest performance sample is used.
+func MinerHashrate(miner *miningcore.MinerStats) miningcore.Hashrate {
+	perf := miner.Performance
+	if perf == nil && len(miner.PerformanceSamples) > 0 {
+		perf = miner.PerformanceSamples[len(miner.PerformanceSamples)-1]
+	}
+	if perf == nil {
+		return 0
+	}
+	var sum miningcore.Hashrate
+	for _, w := range perf.Workers {
+		sum += w.Hashrate
+	}
+	return sum
+}
+
+func averageReward(blocks []*miningcore.Block) float64 {
+	var rewards []float64
+	for _, b := range blocks {
+		if b.Status == miningcore.BlockConfirmed && b.Reward > 0 {
+			rewards = append(rewards, b.Reward)
+		}
+	}
+	return mean(rewards)
+}
+
+func timeToPayout(daily, remaining float64) time.Duration {
+	if remaining <= 0 {
+		return 0
+	}
+	if daily <= 0 {
+		return -1
+	}
+	days := remaining / daily
+	if days*float64(24*time.Hour) >= math.MaxInt64 {
+		return -1
+	}
+	return time.Duration(days * float64(24*time.Hour))
+}
@@ -0,0 +1,183 @@
+// Package chat renders pool notifications and pool summaries as Discord, Telegram and
+// Slack payloads and sends them to the chat APIs.
+package chat
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// ErrUnsupported is returned for notifications that have no chat representation.
+var ErrUnsupported = errors.New("unsupported notification")
+
+// Formatter renders chat payloads.
+type Formatter interface {
+	// Format renders a BlockFoundMessage, BlockUnlockedMessage or PaymentMessage.
+	Format(msg miningcore.Notification) ([]byte, error)
+	// FormatPool renders a summary of a pool.
+	FormatPool(pool *miningcore.PoolInfo) ([]byte, error)
+}
+
+const (
+	colorBlock    = 0x2ecc71
+	colorOrphan   = 0xe74c3c
+	colorPayment  = 0x3498db
+	colorSummary  = 0x95a5a6
+	maxLinkFields = 5
+)
+
+// card is the formatter independent content of a chat message.
+type card struct {
+	title  string
+	url    string
+	color  int
+	fields []field
+}
+
+type field struct {
+	name  string
+	value string
+	link  string
+}
+
+func notificationCard(msg miningcore.Notification) (*card, error) {
+	switch m := msg.(type) {
+	case *miningcore.BlockFoundMessage:
+		return blockFoundCard(m), nil
+	case miningcore.BlockFoundMessage:
+		return blockFoundCard(&m), nil
+	case *miningcore.BlockUnlockedMessage:
+		return blockUnlockedCard(m), nil
+	case miningcore.BlockUnlockedMessage:
+		return blockUnlockedCard(&m), nil
+	case *miningcore.PaymentMessage:
+		return paymentCard(m), nil
+	case miningcore.PaymentMessage:
+		return paymentCard(&m), nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupported, msg.MessageType())
+}
+
+func blockFoundCard(m *miningcore.BlockFoundMessage) *card {
+	c := &card{
+		title: fmt.Sprintf("New %s block found", coinName(m.Name, m.Symbol)),
+		color: colorBlock,
+		fields: []field{
+			{name: "Pool", value: m.PoolID},
+			{name: "Height", value: strconv.FormatUint(m.BlockHeight, 10)},
+		},
+	}
+	if m.Miner != "" {
+		c.fields = append(c.fields, field{name: "Miner", value: m.Miner, link: m.MinerExplorerLink})
+	}
+	if m.Source != "" {
+		c.fields = append(c.fields, field{name: "Source", value: m.Source})
+	}
+	return c
+}
+
+func blockUnlockedCard(m *miningcore.BlockUnlockedMessage) *card {
+	status, color := "confirmed", colorBlock
+	if m.BlockType == "orphan" {
+		status, color = "orphaned", colorOrphan
+	}
+	c := &card{
+		title: fmt.Sprintf("%s block %d %s", coinName(m.Name, m.Symbol), m.BlockHeight, status),
+		url:   m.ExplorerLink,
+		color: color,
+		fields: []field{
+			{name: "Pool", value: m.PoolID},
+			{name: "Height", value: strconv.FormatUint(m.BlockHeight, 10), link: m.ExplorerLink},
+			{name: "Reward", value: formatAmount(m.Reward, m.Symbol)},
+			{name: "Effort", value: fmt.Sprintf("%.2f%%", m.Effort*100)},
+		},
+	}
+	if m.Miner != "" {
+		c.fields = append(c.fields, field{name: "Miner", value: m.Miner, link: m.MinerExplorerLink})
+	}
+	return c
+}
+
+func paymentCard(m *miningcore.PaymentMessage) *card {
+	c := &card{
+		title: fmt.Sprintf("%s payment sent", m.Symbol),
+		color: colorPayment,
+		fields: []field{
+			{name: "Pool", value: m.PoolID},
+			{name: "Amount", value: formatAmount(m.Amount, m.Symbol)},
+			{name: "Recipients", value: strconv.Itoa(m.RecipientsCount)},
+		},
+	}
+	if m.TxFee > 0 {
+		c.fields = append(c.fields, field{name: "Fee", value: formatAmount(m.TxFee, m.Symbol)})
+	}
+	for i, tx := range m.TxIDs {
+		if i == maxLinkFields {
+			break
+		}
+		var link string
+		if i < len(m.TxExplorerLinks) {
+			link = m.TxExplorerLinks[i]
+		}
+		c.fields = append(c.fields, field{name: "Transaction", value: tx, link: link})
+	}
+	if len(m.TxExplorerLinks) > 0 {
+		c.url = m.TxExplorerLinks[0]
+	}
+	return c
+}
+
+func poolCard(p *miningcore.PoolInfo) *card {
+	var name, symbol, algorithm string
+	if p.Coin != nil {
+		name, symbol, algorithm = p.Coin.Name, p.Coin.Symbol, p.Coin.Algorithm
+	}
+	c := &card{
+		title: fmt.Sprintf("%s pool %s", coinName(name, symbol), p.ID),
+		url:   p.AddressInfoLink,
+		color: colorSummary,
+	}
+	if s := p.PoolStats; s != nil {
+		c.fields = append(c.fields,
+			field{name: "Hashrate", value: s.PoolHashrate.Format(algorithm)},
+			field{name: "Miners", value: strconv.Itoa(int(s.ConnectedMiners))},
+		)
+	}
+	if s := p.NetworkStats; s != nil {
+		c.fields = append(c.fields,
+			field{name: "Network hashrate", value: s.NetworkHashrate.Format(algorithm)},
+			field{name: "Network difficulty", value: s.NetworkDifficulty.String()},
+			field{name: "Block height", value: strconv.FormatInt(s.BlockHeight, 10)},
+		)
+	}
+	c.fields = append(c.fields, field{name: "Fee", value: strconv.FormatFloat(p.PoolFeePercent, 'f', -1, 64) + "%"})
+	if p.PaymentProcessing != nil {
+		c.fields = append(c.fields, field{name: "Payout scheme", value: string(p.PaymentProcessing.PayoutScheme)})
+	}
+	c.fields = append(c.fields, field{name: "Total blocks", value: strconv.Itoa(int(p.TotalBlocks))})
+	if p.LastPoolBlockTime != "" {
+		c.fields = append(c.fields, field{name: "Last block", value: p.LastPoolBlockTime})
+	}
+	return c
+}
+
+func coinName(name, symbol string) string {
+	switch {
+	case name != "" && symbol != "":
+		return fmt.Sprintf("%s (%s)", name, symbol)
+	case name != "":
+		return name
+	}
+	return symbol
+}
+
+func formatAmount(v float64, symbol string) string {
+	s := strconv.FormatFloat(v, 'f', -1, 64)
+	if symbol == "" {
+		return s
+	}
+	return s + " " + symbol
+}
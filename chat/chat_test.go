@@ -0,0 +1,125 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	blockFound = &miningcore.BlockFoundMessage{
+		BlockMessage:      miningcore.BlockMessage{PoolID: "eth1", BlockHeight: 15000000, Symbol: "ETH", Name: "Ethereum"},
+		Miner:             "0xabc",
+		MinerExplorerLink: "https://etherscan.io/address/0xabc",
+	}
+	blockUnlocked = miningcore.BlockUnlockedMessage{
+		BlockMessage: miningcore.BlockMessage{PoolID: "eth1", BlockHeight: 15000000, Symbol: "ETH", Name: "Ethereum"},
+		BlockType:    "block",
+		Reward:       2.05,
+		Effort:       0.8512,
+		ExplorerLink: "https://etherscan.io/block/15000000",
+	}
+	payment = &miningcore.PaymentMessage{
+		PoolID:          "eth1",
+		Symbol:          "ETH",
+		TxIDs:           []string{"0xtx1"},
+		TxExplorerLinks: []string{"https://etherscan.io/tx/0xtx1"},
+		RecipientsCount: 3,
+		Amount:          1.5,
+	}
+	pool = &miningcore.PoolInfo{
+		ID:             "eth1",
+		Coin:           &miningcore.APICoinConfig{Name: "Ethereum", Symbol: "ETH", Algorithm: "Ethash"},
+		PoolFeePercent: 1,
+		PoolStats:      &miningcore.PoolStats{ConnectedMiners: 12, PoolHashrate: 1.5e9},
+		NetworkStats:   &miningcore.BlockchainStats{BlockHeight: 15000001, NetworkHashrate: 9e14, NetworkDifficulty: 1.2e16},
+	}
+)
+
+func TestDiscord(t *testing.T) {
+	b, err := Discord{Username: "pool"}.Format(blockFound)
+	assert.NoError(t, err)
+	var p discordPayload
+	assert.NoError(t, json.Unmarshal(b, &p))
+	assert.Equal(t, "pool", p.Username)
+	assert.Len(t, p.Embeds, 1)
+	assert.Equal(t, "New Ethereum (ETH) block found", p.Embeds[0].Title)
+	assert.Contains(t, p.Embeds[0].Fields, discordField{Name: "Miner", Value: "[0xabc](https://etherscan.io/address/0xabc)"})
+	assert.Contains(t, p.Embeds[0].Fields, discordField{Name: "Height", Value: "15000000", Inline: true})
+
+	b, err = Discord{}.Format(blockUnlocked)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(b, &p))
+	assert.Equal(t, "https://etherscan.io/block/15000000", p.Embeds[0].URL)
+	assert.Contains(t, p.Embeds[0].Fields, discordField{Name: "Effort", Value: "85.12%", Inline: true})
+	assert.Contains(t, p.Embeds[0].Fields, discordField{Name: "Reward", Value: "2.05 ETH", Inline: true})
+
+	b, err = Discord{}.FormatPool(pool)
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(b, &p))
+	assert.Equal(t, "Ethereum (ETH) pool eth1", p.Embeds[0].Title)
+	assert.Contains(t, p.Embeds[0].Fields, discordField{Name: "Miners", Value: "12", Inline: true})
+}
+
+func TestTelegram(t *testing.T) {
+	text, err := Telegram{}.Text(payment)
+	assert.NoError(t, err)
+	assert.Equal(t, "*[ETH payment sent](https://etherscan.io/tx/0xtx1)*\n"+
+		"\nPool: `eth1`"+
+		"\nAmount: `1.5 ETH`"+
+		"\nRecipients: `3`"+
+		"\nTransaction: [0xtx1](https://etherscan.io/tx/0xtx1)", text)
+
+	b, err := Telegram{ChatID: "-100"}.FormatPool(pool)
+	assert.NoError(t, err)
+	var p telegramPayload
+	assert.NoError(t, json.Unmarshal(b, &p))
+	assert.Equal(t, "-100", p.ChatID)
+	assert.Equal(t, "MarkdownV2", p.ParseMode)
+	assert.Contains(t, p.Text, "*Ethereum \\(ETH\\) pool eth1*")
+
+	assert.Equal(t, `1\.5 \(a\_b\) \\ \!`, EscapeMarkdownV2(`1.5 (a_b) \ !`))
+}
+
+func TestSlack(t *testing.T) {
+	b, err := Slack{}.Format(payment)
+	assert.NoError(t, err)
+	var p slackPayload
+	assert.NoError(t, json.Unmarshal(b, &p))
+	assert.Equal(t, "ETH payment sent", p.Text)
+	assert.Len(t, p.Blocks, 3)
+	assert.Equal(t, "header", p.Blocks[0].Type)
+	assert.Contains(t, p.Blocks[1].Fields, slackText{Type: "mrkdwn", Text: "*Transaction*\n<https://etherscan.io/tx/0xtx1|0xtx1>"})
+	assert.Equal(t, "<https://etherscan.io/tx/0xtx1|View in explorer>", p.Blocks[2].Text.Text)
+}
+
+func TestUnsupported(t *testing.T) {
+	_, err := Slack{}.Format(&miningcore.ChainHeightMessage{})
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestSender(t *testing.T) {
+	var got []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		got, _ = io.ReadAll(r.Body)
+		if r.URL.Path == "/fail" {
+			http.Error(w, "invalid payload", http.StatusBadRequest)
+		}
+	}))
+	defer srv.Close()
+
+	b, err := Discord{}.Format(blockFound)
+	assert.NoError(t, err)
+	assert.NoError(t, NewSender(srv.URL).Send(context.Background(), b))
+	assert.JSONEq(t, string(b), string(got))
+
+	err = NewSender(srv.URL+"/fail").Send(context.Background(), b)
+	assert.ErrorContains(t, err, "invalid payload")
+}
@@ -0,0 +1,58 @@
+package chat
+
+import (
+	"encoding/json"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Discord renders Discord webhook payloads with a single embed.
+type Discord struct {
+	Username  string
+	AvatarURL string
+}
+
+type discordPayload struct {
+	Username  string         `json:"username,omitempty"`
+	AvatarURL string         `json:"avatar_url,omitempty"`
+	Embeds    []discordEmbed `json:"embeds"`
+}
+
+type discordEmbed struct {
+	Title  string         `json:"title"`
+	URL    string         `json:"url,omitempty"`
+	Color  int            `json:"color"`
+	Fields []discordField `json:"fields"`
+}
+
+type discordField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline"`
+}
+
+// Format implements Formatter.
+func (d Discord) Format(msg miningcore.Notification) ([]byte, error) {
+	c, err := notificationCard(msg)
+	if err != nil {
+		return nil, err
+	}
+	return d.render(c)
+}
+
+// FormatPool implements Formatter.
+func (d Discord) FormatPool(pool *miningcore.PoolInfo) ([]byte, error) {
+	return d.render(poolCard(pool))
+}
+
+func (d Discord) render(c *card) ([]byte, error) {
+	embed := discordEmbed{Title: c.title, URL: c.url, Color: c.color, Fields: []discordField{}}
+	for _, f := range c.fields {
+		value := f.value
+		if f.link != "" {
+			value = "[" + f.value + "](" + f.link + ")"
+		}
+		embed.Fields = append(embed.Fields, discordField{Name: f.name, Value: value, Inline: f.link == ""})
+	}
+	return json.Marshal(discordPayload{Username: d.Username, AvatarURL: d.AvatarURL, Embeds: []discordEmbed{embed}})
+}
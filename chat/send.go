@@ -0,0 +1,41 @@
+package chat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Sender posts rendered payloads to a chat webhook or API URL, e.g. a Discord or Slack
+// webhook or https://api.telegram.org/bot<token>/sendMessage.
+type Sender struct {
+	URL  string
+	HTTP *http.Client
+}
+
+// NewSender creates a sender for the given URL.
+func NewSender(url string) *Sender {
+	return &Sender{URL: url, HTTP: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Send posts the payload as JSON.
+func (s *Sender) Send(ctx context.Context, payload []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("chat api returned %s: %s", resp.Status, body)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package chat
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Slack renders incoming webhook payloads using Block Kit.
+type Slack struct{}
+
+type slackPayload struct {
+	Text   string       `json:"text"`
+	Blocks []slackBlock `json:"blocks"`
+}
+
+type slackBlock struct {
+	Type   string      `json:"type"`
+	Text   *slackText  `json:"text,omitempty"`
+	Fields []slackText `json:"fields,omitempty"`
+}
+
+type slackText struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// slack allows at most 10 fields per section
+const slackMaxFields = 10
+
+// Format implements Formatter.
+func (s Slack) Format(msg miningcore.Notification) ([]byte, error) {
+	c, err := notificationCard(msg)
+	if err != nil {
+		return nil, err
+	}
+	return s.render(c)
+}
+
+// FormatPool implements Formatter.
+func (s Slack) FormatPool(pool *miningcore.PoolInfo) ([]byte, error) {
+	return s.render(poolCard(pool))
+}
+
+func (s Slack) render(c *card) ([]byte, error) {
+	p := slackPayload{
+		Text:   c.title,
+		Blocks: []slackBlock{{Type: "header", Text: &slackText{Type: "plain_text", Text: c.title}}},
+	}
+	var section *slackBlock
+	for _, f := range c.fields {
+		if section == nil || len(section.Fields) == slackMaxFields {
+			p.Blocks = append(p.Blocks, slackBlock{Type: "section"})
+			section = &p.Blocks[len(p.Blocks)-1]
+		}
+		value := escapeSlack(f.value)
+		if f.link != "" {
+			value = "<" + f.link + "|" + value + ">"
+		}
+		section.Fields = append(section.Fields, slackText{Type: "mrkdwn", Text: "*" + escapeSlack(f.name) + "*\n" + value})
+	}
+	if c.url != "" {
+		p.Blocks = append(p.Blocks, slackBlock{Type: "section", Text: &slackText{Type: "mrkdwn", Text: "<" + c.url + "|View in explorer>"}})
+	}
+	return json.Marshal(p)
+}
+
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func escapeSlack(s string) string {
+	return slackEscaper.Replace(s)
+}
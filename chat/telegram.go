@@ -0,0 +1,99 @@
+package chat
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Telegram renders sendMessage payloads with MarkdownV2 text.
+type Telegram struct {
+	ChatID string
+}
+
+type telegramPayload struct {
+	ChatID                string `json:"chat_id"`
+	Text                  string `json:"text"`
+	ParseMode             string `json:"parse_mode"`
+	DisableWebPagePreview bool   `json:"disable_web_page_preview"`
+}
+
+// Format implements Formatter.
+func (t Telegram) Format(msg miningcore.Notification) ([]byte, error) {
+	c, err := notificationCard(msg)
+	if err != nil {
+		return nil, err
+	}
+	return t.render(c)
+}
+
+// FormatPool implements Formatter.
+func (t Telegram) FormatPool(pool *miningcore.PoolInfo) ([]byte, error) {
+	return t.render(poolCard(pool))
+}
+
+// Text renders a notification as MarkdownV2 text.
+func (t Telegram) Text(msg miningcore.Notification) (string, error) {
+	c, err := notificationCard(msg)
+	if err != nil {
+		return "", err
+	}
+	return telegramText(c), nil
+}
+
+func (t Telegram) render(c *card) ([]byte, error) {
+	return json.Marshal(telegramPayload{
+		ChatID:                t.ChatID,
+		Text:                  telegramText(c),
+		ParseMode:             "MarkdownV2",
+		DisableWebPagePreview: true,
+	})
+}
+
+func telegramText(c *card) string {
+	var b strings.Builder
+	b.WriteString("*")
+	if c.url != "" {
+		b.WriteString("[" + EscapeMarkdownV2(c.title) + "](" + escapeMarkdownV2URL(c.url) + ")")
+	} else {
+		b.WriteString(EscapeMarkdownV2(c.title))
+	}
+	b.WriteString("*\n")
+	for _, f := range c.fields {
+		b.WriteString("\n" + EscapeMarkdownV2(f.name) + ": ")
+		if f.link != "" {
+			b.WriteString("[" + EscapeMarkdownV2(f.value) + "](" + escapeMarkdownV2URL(f.link) + ")")
+		} else {
+			b.WriteString("`" + escapeMarkdownV2Code(f.value) + "`")
+		}
+	}
+	return b.String()
+}
+
+var (
+	markdownV2Escaper     = strings.NewReplacer(markdownV2Pairs(`\_*[]()~` + "`" + `>#+-=|{}.!`)...)
+	markdownV2URLEscaper  = strings.NewReplacer(`\`, `\\`, `)`, `\)`)
+	markdownV2CodeEscaper = strings.NewReplacer(`\`, `\\`, "`", "\\`")
+)
+
+// EscapeMarkdownV2 escapes all characters that have a meaning in Telegram MarkdownV2.
+func EscapeMarkdownV2(s string) string {
+	return markdownV2Escaper.Replace(s)
+}
+
+func escapeMarkdownV2URL(s string) string {
+	return markdownV2URLEscaper.Replace(s)
+}
+
+func escapeMarkdownV2Code(s string) string {
+	return markdownV2CodeEscaper.Replace(s)
+}
+
+func markdownV2Pairs(chars string) []string {
+	pairs := make([]string, 0, len(chars)*2)
+	for _, c := range chars {
+		pairs = append(pairs, string(c), `\`+string(c))
+	}
+	return pairs
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -50,13 +51,31 @@ func WithJSONDecoder(decoder func(data []byte, v interface{}) error) ClientOpts
 	}
 }
 
+// WithAddressValidation validates miner addresses against the coin family of the pool before a request is sent.
+// The coin family is looked up once per pool and cached.
+func WithAddressValidation() ClientOpts {
+	return func(c *Client) {
+		c.validateAddr = true
+	}
+}
+
+// WithAddressNormalization normalizes miner addresses before a request is sent, see NormalizeAddress.
+func WithAddressNormalization() ClientOpts {
+	return func(c *Client) {
+		c.normalizeAddr = true
+	}
+}
+
 // Client represents a client for the miningcore API.
 type Client struct {
-	timeout     time.Duration
-	url         string
-	http        *http.Client
-	jsonEncoder func(v interface{}) ([]byte, error)
-	jsonDecoder func(data []byte, v interface{}) error
+	timeout       time.Duration
+	url           string
+	http          *http.Client
+	jsonEncoder   func(v interface{}) ([]byte, error)
+	jsonDecoder   func(data []byte, v interface{}) error
+	validateAddr  bool
+	normalizeAddr bool
+	families      sync.Map
 }
 
 // New creates a new client for the miningcore API.
@@ -129,7 +148,11 @@ func buildRequestURL(base, endpoint string, params ...map[string]string) (string
 	if err != nil {
 		return "", err
 	}
-	u.Path = endpoint
+	u.Path, err = url.PathUnescape(endpoint)
+	if err != nil {
+		return "", err
+	}
+	u.RawPath = endpoint
 	if len(params) == 0 {
 		return u.String(), nil
 	}
@@ -140,3 +163,48 @@ func buildRequestURL(base, endpoint string, params ...map[string]string) (string
 	u.RawQuery = p.Encode()
 	return u.String(), nil
 }
+
+// endpoint formats an API path and escapes all arguments as path segments.
+func endpoint(format string, args ...string) string {
+	escaped := make([]any, len(args))
+	for i, a := range args {
+		escaped[i] = url.PathEscape(a)
+	}
+	return fmt.Sprintf(format, escaped...)
+}
+
+// prepareAddress normalizes and validates a miner address depending on the client options.
+func (c *Client) prepareAddress(ctx context.Context, id, addr string) (string, error) {
+	if !c.validateAddr && !c.normalizeAddr {
+		return addr, nil
+	}
+	family, err := c.poolFamily(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if c.normalizeAddr {
+		addr = NormalizeAddress(family, addr)
+	}
+	if c.validateAddr {
+		if err := ValidateAddress(family, addr); err != nil {
+			return "", err
+		}
+	}
+	return addr, nil
+}
+
+func (c *Client) poolFamily(ctx context.Context, id string) (CoinFamily, error) {
+	if f, ok := c.families.Load(id); ok {
+		return f.(CoinFamily), nil
+	}
+	pool, _, err := c.GetPool(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	var family CoinFamily
+	if pool.Coin != nil {
+		family = pool.Coin.Family
+	}
+	c.families.Store(id, family)
+	return family, nil
+}
@@ -0,0 +1,106 @@
+// Command miningcore-health checks a miningcore instance. By default it runs the checks once,
+// prints a Nagios plugin compatible report and exits with the matching exit code. With -listen
+// it serves the report on /healthz for Kubernetes probes instead. Stuck block heights are only
+// detected across runs, one-shot runs need -state for that.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/health"
+)
+
+func main() {
+	apiURL := flag.String("url", "http://localhost:4000", "miningcore api url")
+	pools := flag.String("pools", "", "comma separated pool ids to check, all pools if empty")
+	maxBlockAge := flag.Duration("max-block-age", time.Hour, "maximum age of the last network block")
+	noPayments := flag.String("no-payments", "", "comma separated pool ids without payment processing")
+	stratumHost := flag.String("stratum-host", "", "host to check the stratum ports on, defaults to the api host")
+	noPorts := flag.Bool("no-ports", false, "skip the stratum port checks")
+	timeout := flag.Duration("timeout", 10*time.Second, "timeout of the api requests and port checks")
+	asJSON := flag.Bool("json", false, "print the report as json")
+	listen := flag.String("listen", "", "serve the report on /healthz at this address instead of exiting")
+	failOn := flag.String("fail-on", "critical", "least severe status that fails the probe: warning, unknown or critical")
+	stateFile := flag.String("state", "", "file to keep the block heights in between runs")
+	flag.Parse()
+
+	opts := []health.CheckerOpts{
+		health.WithMaxBlockAge(*maxBlockAge),
+		health.WithDialTimeout(*timeout),
+		health.WithoutPayments(split(*noPayments)...),
+	}
+	if ids := split(*pools); len(ids) > 0 {
+		opts = append(opts, health.WithPools(ids...))
+	}
+	host := *stratumHost
+	if host == "" {
+		if u, err := url.Parse(*apiURL); err == nil {
+			host = u.Hostname()
+		}
+	}
+	if host != "" {
+		opts = append(opts, health.WithStratumHost(host))
+	}
+	if *noPorts {
+		opts = append(opts, health.WithoutPortChecks())
+	}
+	if *stateFile != "" {
+		opts = append(opts, health.WithStateFile(*stateFile))
+	}
+	checker := health.New(miningcore.New(*apiURL, miningcore.WithTimeout(*timeout)), opts...)
+
+	if *listen != "" {
+		status, err := parseStatus(*failOn)
+		if err != nil {
+			log.Fatal(err)
+		}
+		http.Handle("/healthz", health.Handler(checker, status))
+		log.Fatal(http.ListenAndServe(*listen, nil))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*(*timeout))
+	defer cancel()
+	report := checker.Check(ctx)
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Fatal(err)
+		}
+	} else if err := report.WriteText(os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(report.ExitCode())
+}
+
+func split(s string) []string {
+	var res []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+func parseStatus(s string) (health.Status, error) {
+	switch strings.ToLower(s) {
+	case "warning":
+		return health.Warning, nil
+	case "critical":
+		return health.Critical, nil
+	case "unknown":
+		return health.Unknown, nil
+	}
+	return 0, fmt.Errorf("invalid status %q", s)
+}
@@ -0,0 +1,69 @@
+// Command miningcore-proxy serves the miningcore api from an upstream instance with caching,
+// per client rate limiting, CORS headers and address filtering.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/proxy"
+)
+
+func main() {
+	upstream := flag.String("upstream", "http://localhost:4000", "miningcore api url")
+	listen := flag.String("listen", ":8080", "listen address")
+	cacheTTL := flag.Duration("cache-ttl", 10*time.Second, "how long responses are cached, 0 disables the cache")
+	rps := flag.Float64("rate", 10, "requests per second per client ip, 0 disables rate limiting")
+	burst := flag.Int("burst", 20, "request burst per client ip")
+	trustedProxies := flag.Int("trusted-proxies", 0, "number of trusted reverse proxies in front, takes the client ip from X-Forwarded-For if set")
+	origins := flag.String("cors", "", "comma separated origins allowed by CORS, * for all")
+	allow := flag.String("allow", "", "comma separated addresses the miner endpoints are limited to")
+	block := flag.String("block", "", "comma separated addresses the miner endpoints are refused for")
+	validate := flag.Bool("validate", false, "reject invalid miner addresses without asking the upstream")
+	timeout := flag.Duration("timeout", 20*time.Second, "timeout of upstream requests")
+	flag.Parse()
+
+	clientOpts := []miningcore.ClientOpts{miningcore.WithTimeout(*timeout)}
+	if *validate {
+		clientOpts = append(clientOpts, miningcore.WithAddressValidation())
+	}
+	opts := []proxy.ServerOpts{
+		proxy.WithCacheTTL(*cacheTTL),
+		proxy.WithCORS(split(*origins)...),
+		proxy.WithErrorHandler(func(err error) { log.Println("upstream:", err) }),
+	}
+	if *rps > 0 {
+		opts = append(opts, proxy.WithRateLimit(*rps, *burst))
+	}
+	if *trustedProxies > 0 {
+		opts = append(opts, proxy.WithForwardedFor(*trustedProxies))
+	}
+	if addrs := split(*allow); len(addrs) > 0 {
+		opts = append(opts, proxy.WithAllowedAddresses(addrs...))
+	}
+	if addrs := split(*block); len(addrs) > 0 {
+		opts = append(opts, proxy.WithBlockedAddresses(addrs...))
+	}
+
+	srv := &http.Server{
+		Addr:              *listen,
+		Handler:           proxy.New(miningcore.New(*upstream, clientOpts...), opts...),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Printf("serving %s on %s", *upstream, *listen)
+	log.Fatal(srv.ListenAndServe())
+}
+
+func split(s string) []string {
+	var res []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
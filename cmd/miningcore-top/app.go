@@ -0,0 +1,275 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"golang.org/x/term"
+)
+
+type view int
+
+const (
+	viewPools view = iota
+	viewPool
+	viewMiner
+)
+
+const (
+	blockRows  = 10
+	minerRows  = 20
+	eventLines = 5
+)
+
+// app holds the state of the dashboard. It is only accessed from the run loop,
+// fetches run in the background and hand their results back as update functions.
+type app struct {
+	client   *miningcore.Client
+	ids      []string
+	perfMode string
+
+	view     view
+	poolSel  int
+	minerSel int
+	poolID   string
+	miner    string
+
+	pools      []*miningcore.PoolInfo
+	blocks     []*miningcore.Block
+	miners     []*miningcore.MinerPerformanceStats
+	minerStats *miningcore.MinerStats
+	samples    []*miningcore.WorkerStats
+
+	events  []string
+	err     error
+	updated time.Time
+
+	loading bool
+	pending bool
+	updates chan func(*app)
+}
+
+func newApp(c *miningcore.Client, ids []string, perfMode string) *app {
+	return &app{
+		client:   c,
+		ids:      ids,
+		perfMode: perfMode,
+		updates:  make(chan func(*app), 1),
+	}
+}
+
+// run handles keys, notifications and fetch results until q is pressed or the context is canceled.
+func (a *app) run(ctx context.Context, interval time.Duration, keys <-chan key, events <-chan miningcore.Notification, errs <-chan error) {
+	refresh := time.NewTicker(interval)
+	defer refresh.Stop()
+	// redraw every second to keep the relative times current
+	redraw := time.NewTicker(time.Second)
+	defer redraw.Stop()
+
+	a.refresh(ctx)
+	for {
+		a.render()
+		select {
+		case <-ctx.Done():
+			return
+		case k, ok := <-keys:
+			if !ok || k == keyQuit {
+				return
+			}
+			a.handleKey(ctx, k)
+		case fn := <-a.updates:
+			fn(a)
+			a.loading = false
+			if a.pending {
+				a.pending = false
+				a.refresh(ctx)
+			}
+		case n := <-events:
+			if n.MessageType() == miningcore.WsHashrateUpdated {
+				continue
+			}
+			a.addEvent(n)
+			a.refresh(ctx)
+		case err := <-errs:
+			a.err = err
+		case <-refresh.C:
+			a.refresh(ctx)
+		case <-redraw.C:
+		}
+	}
+}
+
+func (a *app) handleKey(ctx context.Context, k key) {
+	switch k {
+	case keyUp:
+		a.move(-1)
+	case keyDown:
+		a.move(1)
+	case keyRefresh:
+		a.refresh(ctx)
+	case keyEnter:
+		switch a.view {
+		case viewPools:
+			if a.poolSel < len(a.pools) {
+				a.view, a.poolID, a.minerSel = viewPool, a.pools[a.poolSel].ID, 0
+				a.blocks, a.miners = nil, nil
+				a.refresh(ctx)
+			}
+		case viewPool:
+			if a.minerSel < len(a.miners) {
+				a.view, a.miner = viewMiner, a.miners[a.minerSel].Miner
+				a.minerStats, a.samples = nil, nil
+				a.refresh(ctx)
+			}
+		}
+	case keyBack:
+		if a.view > viewPools {
+			a.view--
+			a.refresh(ctx)
+		}
+	}
+}
+
+func (a *app) move(delta int) {
+	switch a.view {
+	case viewPools:
+		a.poolSel = clamp(a.poolSel+delta, len(a.pools))
+	case viewPool:
+		a.minerSel = clamp(a.minerSel+delta, len(a.miners))
+	}
+}
+
+func (a *app) addEvent(n miningcore.Notification) {
+	line := fmt.Sprintf("%s %-8s %s", time.Now().Format("15:04:05"), miningcore.PoolID(n), describe(n))
+	a.events = append(a.events, line)
+	if len(a.events) > eventLines {
+		a.events = a.events[len(a.events)-eventLines:]
+	}
+}
+
+// refresh fetches the data of the current view in the background.
+// Only one fetch runs at a time, a refresh requested meanwhile runs after it.
+func (a *app) refresh(ctx context.Context) {
+	if a.loading {
+		a.pending = true
+		return
+	}
+	a.loading = true
+	v, poolID, addr := a.view, a.poolID, a.miner
+	go func() {
+		fn := a.fetch(ctx, v, poolID, addr)
+		select {
+		case a.updates <- fn:
+		case <-ctx.Done():
+		}
+	}()
+}
+
+func (a *app) fetch(ctx context.Context, v view, poolID, addr string) func(*app) {
+	fail := func(err error) func(*app) {
+		return func(a *app) { a.err = err }
+	}
+
+	all, _, err := a.client.GetPools(ctx)
+	if err != nil {
+		return fail(err)
+	}
+	pools := filterPools(all, a.ids)
+
+	var blocks []*miningcore.Block
+	var miners []*miningcore.MinerPerformanceStats
+	var stats *miningcore.MinerStats
+	var samples []*miningcore.WorkerStats
+	switch v {
+	case viewPool:
+		res, _, err := a.client.GetPoolBlocks(ctx, poolID, map[string]string{"page": "0", "pageSize": fmt.Sprint(blockRows)})
+		if err != nil {
+			return fail(err)
+		}
+		blocks = res.Result
+		if miners, _, err = a.client.GetMiners(ctx, poolID, map[string]string{"page": "0", "pageSize": fmt.Sprint(minerRows)}); err != nil {
+			return fail(err)
+		}
+	case viewMiner:
+		if stats, _, err = a.client.GetMiner(ctx, poolID, addr); err != nil {
+			return fail(err)
+		}
+		if samples, _, err = a.client.GetMinerPerformance(ctx, poolID, addr, map[string]string{"mode": a.perfMode}); err != nil {
+			return fail(err)
+		}
+	}
+
+	return func(a *app) {
+		a.err = nil
+		a.updated = time.Now()
+		a.pools = pools
+		a.poolSel = clamp(a.poolSel, len(pools))
+		if a.view != v || a.poolID != poolID || a.miner != addr {
+			return
+		}
+		switch v {
+		case viewPool:
+			a.blocks, a.miners = blocks, miners
+			a.minerSel = clamp(a.minerSel, len(miners))
+		case viewMiner:
+			a.minerStats, a.samples = stats, samples
+		}
+	}
+}
+
+func (a *app) render() {
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		width, height = 80, 24
+	}
+	var lines []string
+	switch a.view {
+	case viewPools:
+		lines = a.renderPools()
+	case viewPool:
+		lines = a.renderPool()
+	case viewMiner:
+		lines = a.renderMiner(width)
+	}
+	lines = layout(a.header(), lines, a.footer(), height)
+	os.Stdout.WriteString(screen(lines, width))
+}
+
+func (a *app) pool(id string) *miningcore.PoolInfo {
+	for _, p := range a.pools {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+func filterPools(pools []*miningcore.PoolInfo, ids []string) []*miningcore.PoolInfo {
+	if len(ids) == 0 {
+		return pools
+	}
+	byID := make(map[string]*miningcore.PoolInfo, len(pools))
+	for _, p := range pools {
+		byID[p.ID] = p
+	}
+	var res []*miningcore.PoolInfo
+	for _, id := range ids {
+		if p, ok := byID[id]; ok {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+func clamp(i, n int) int {
+	if i >= n {
+		i = n - 1
+	}
+	if i < 0 {
+		i = 0
+	}
+	return i
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"golang.org/x/net/websocket"
+)
+
+type key int
+
+const (
+	keyUp key = iota
+	keyDown
+	keyEnter
+	keyBack
+	keyRefresh
+	keyQuit
+)
+
+// readKeys reads keys from a terminal in raw mode until it fails.
+func readKeys(r io.Reader) <-chan key {
+	keys := make(chan key)
+	go func() {
+		defer close(keys)
+		buf := make([]byte, 16)
+		for {
+			n, err := r.Read(buf)
+			if err != nil {
+				return
+			}
+			k, ok := parseKey(buf[:n])
+			if ok {
+				keys <- k
+			}
+		}
+	}()
+	return keys
+}
+
+func parseKey(b []byte) (key, bool) {
+	switch string(b) {
+	case "\x1b[A", "\x1bOA", "k":
+		return keyUp, true
+	case "\x1b[B", "\x1bOB", "j":
+		return keyDown, true
+	case "\r", "\n", "\x1b[C", "l":
+		return keyEnter, true
+	case "\x1b", "\x7f", "\b", "\x1b[D", "h":
+		return keyBack, true
+	case "r":
+		return keyRefresh, true
+	case "q", "\x03", "\x04":
+		return keyQuit, true
+	}
+	return 0, false
+}
+
+// minRetry and maxRetry bound the delay before reconnecting to the notification stream.
+var (
+	minRetry = time.Second
+	maxRetry = time.Minute
+)
+
+// subscribe reads the miningcore notification stream until the context is canceled and sends
+// the notifications to ch. Failed connections are reported to onError and retried with a
+// growing delay.
+func subscribe(ctx context.Context, wsURL, origin string, ch chan<- miningcore.Notification, onError func(error)) {
+	delay := minRetry
+	for {
+		connected, err := receive(ctx, wsURL, origin, ch)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			delay = minRetry
+		}
+		onError(fmt.Errorf("notifications: %w, reconnecting in %s", err, delay))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > maxRetry {
+			delay = maxRetry
+		}
+	}
+}
+
+// receive reads from a single connection to the notification stream until it fails.
+// It reports whether the connection was established.
+func receive(ctx context.Context, wsURL, origin string, ch chan<- miningcore.Notification) (bool, error) {
+	conn, err := websocket.Dial(wsURL, "", origin)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(conn, &data); err != nil {
+			return true, err
+		}
+		n, err := miningcore.DecodeNotification(data)
+		if err != nil || n == nil {
+			continue
+		}
+		select {
+		case ch <- n:
+		case <-ctx.Done():
+			return true, ctx.Err()
+		}
+	}
+}
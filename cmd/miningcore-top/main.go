@@ -0,0 +1,95 @@
+// Command miningcore-top is a terminal dashboard for a miningcore instance. It lists the pools
+// with their hashrate, miners, network difficulty and last block, shows the blocks and top
+// miners of a pool and the per worker hashrate of a miner. The data is refreshed on an interval
+// and whenever a notification arrives, either from the /notifications websocket or, if none is
+// given, from polling the api.
+//
+// Keys: up/down or j/k select, enter opens, esc or backspace goes back, r refreshes, q quits.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/feed"
+	"golang.org/x/term"
+)
+
+func main() {
+	apiURL := flag.String("url", "http://localhost:4000", "miningcore api url")
+	pools := flag.String("pools", "", "comma separated pool ids to show, all pools if empty")
+	interval := flag.Duration("interval", 10*time.Second, "refresh interval")
+	notifications := flag.String("notifications", "", "websocket url of the notification stream, e.g. ws://localhost:4000/notifications; polls the api for events if empty")
+	perfMode := flag.String("perf", "Day", "sample range of the miner view: Hour, Day or Month")
+	timeout := flag.Duration("timeout", 10*time.Second, "timeout of the api requests")
+	flag.Parse()
+
+	if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) {
+		log.Fatal("miningcore-top needs to run in a terminal")
+	}
+
+	client := miningcore.New(*apiURL, miningcore.WithTimeout(*timeout))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ids := split(*pools)
+	if len(ids) == 0 {
+		all, _, err := client.GetPools(ctx)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, p := range all {
+			ids = append(ids, p.ID)
+		}
+	}
+
+	events := make(chan miningcore.Notification, 64)
+	errs := make(chan error, 8)
+	onError := func(err error) {
+		select {
+		case errs <- err:
+		default:
+		}
+	}
+	if *notifications != "" {
+		go subscribe(ctx, *notifications, *apiURL, events, onError)
+	} else {
+		f := feed.New(client, ids, feed.WithInterval(*interval), feed.WithErrorHandler(onError))
+		go f.Run(ctx, func(n miningcore.Notification) {
+			select {
+			case events <- n:
+			case <-ctx.Done():
+			}
+		})
+	}
+
+	state, err := term.MakeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		log.Fatal(err)
+	}
+	// alternate screen and hidden cursor until exit
+	fmt.Fprint(os.Stdout, "\x1b[?1049h\x1b[?25l")
+	defer func() {
+		fmt.Fprint(os.Stdout, "\x1b[?25h\x1b[?1049l")
+		term.Restore(int(os.Stdin.Fd()), state)
+	}()
+
+	a := newApp(client, ids, *perfMode)
+	a.run(ctx, *interval, readKeys(os.Stdin), events, errs)
+}
+
+func split(s string) []string {
+	var res []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+)
+
+func TestParseKey(t *testing.T) {
+	keys := map[string]key{
+		"\x1b[A": keyUp,
+		"j":      keyDown,
+		"\r":     keyEnter,
+		"\x1b":   keyBack,
+		"r":      keyRefresh,
+		"\x03":   keyQuit,
+	}
+	for in, want := range keys {
+		k, ok := parseKey([]byte(in))
+		assert.True(t, ok, "%q", in)
+		assert.Equal(t, want, k, "%q", in)
+	}
+	_, ok := parseKey([]byte("x"))
+	assert.False(t, ok)
+}
+
+func TestWorkerSeries(t *testing.T) {
+	samples := []*miningcore.WorkerStats{
+		{Created: "2022-07-01T01:00:00Z", Workers: map[string]*miningcore.WorkerPerformanceStats{"rig1": {Hashrate: 20}, "rig2": {Hashrate: 5}}},
+		{Created: "2022-07-01T00:00:00Z", Workers: map[string]*miningcore.WorkerPerformanceStats{"rig1": {Hashrate: 10}}},
+	}
+	assert.Equal(t, map[string][]float64{
+		"rig1": {10, 20},
+		"rig2": {0, 5},
+	}, workerSeries(samples))
+}
+
+func TestSparkline(t *testing.T) {
+	assert.Equal(t, "▁▄█", sparkline([]float64{0, 5, 10}, 10))
+	assert.Equal(t, "▄█", sparkline([]float64{0, 5, 10}, 2))
+	assert.Equal(t, "▁▁", sparkline([]float64{0, 0}, 2))
+	assert.Equal(t, "", sparkline([]float64{1}, 0))
+}
+
+func TestClamp(t *testing.T) {
+	assert.Equal(t, 0, clamp(-1, 3))
+	assert.Equal(t, 2, clamp(5, 3))
+	assert.Equal(t, 1, clamp(1, 3))
+	assert.Equal(t, 0, clamp(1, 0))
+}
+
+func TestSubscribeReconnects(t *testing.T) {
+	minRetry = time.Millisecond
+	var conns int32
+	// every connection sends one notification and is closed
+	ts := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		n := atomic.AddInt32(&conns, 1)
+		websocket.Message.Send(ws, `{"type":"newchainheight","poolId":"eth","blockHeight":`+strconv.Itoa(int(n))+`}`)
+		ws.Close()
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	ch := make(chan miningcore.Notification)
+	var errs int32
+	done := make(chan struct{})
+	go func() {
+		subscribe(ctx, "ws"+strings.TrimPrefix(ts.URL, "http"), ts.URL, ch, func(error) { atomic.AddInt32(&errs, 1) })
+		close(done)
+	}()
+	defer func() {
+		cancel()
+		<-done
+		minRetry = time.Second
+	}()
+
+	for _, height := range []uint64{1, 2} {
+		select {
+		case n := <-ch:
+			assert.Equal(t, height, n.(*miningcore.ChainHeightMessage).BlockHeight)
+		case <-ctx.Done():
+			t.Fatal("no notification")
+		}
+	}
+	assert.GreaterOrEqual(t, atomic.LoadInt32(&errs), int32(1))
+}
@@ -0,0 +1,352 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+const (
+	reverse = "\x1b[7m"
+	bold    = "\x1b[1m"
+	red     = "\x1b[31m"
+	reset   = "\x1b[0m"
+)
+
+var sparks = []rune("▁▂▃▄▅▆▇█")
+
+func (a *app) header() []string {
+	title := "miningcore-top"
+	switch a.view {
+	case viewPool:
+		title += " › " + a.poolID
+	case viewMiner:
+		title += " › " + a.poolID + " › " + a.miner
+	}
+	updated := "loading"
+	if !a.updated.IsZero() {
+		updated = "updated " + ago(a.updated)
+	}
+	return []string{bold + title + reset + "  " + updated, ""}
+}
+
+func (a *app) footer() []string {
+	var lines []string
+	if len(a.events) > 0 {
+		lines = append(lines, bold+"EVENTS"+reset)
+		lines = append(lines, a.events...)
+	}
+	if a.err != nil {
+		// errors of the api contain the response body, keep them on a single line
+		msg := strings.Join(strings.Fields(a.err.Error()), " ")
+		lines = append(lines, red+"error: "+msg+reset)
+	}
+	help := "↑/↓ select  enter open  esc back  r refresh  q quit"
+	return append(lines, "", help)
+}
+
+func (a *app) renderPools() []string {
+	rows := [][]string{{"POOL", "COIN", "ALGORITHM", "HASHRATE", "MINERS", "NET DIFFICULTY", "HEIGHT", "LAST BLOCK", "EFFORT"}}
+	for _, p := range a.pools {
+		var symbol, algo string
+		if p.Coin != nil {
+			symbol, algo = p.Coin.Symbol, p.Coin.Algorithm
+		}
+		hashrate, miners := "-", "-"
+		if p.PoolStats != nil {
+			hashrate = p.PoolStats.PoolHashrate.Format(algo)
+			miners = fmt.Sprint(p.PoolStats.ConnectedMiners)
+		}
+		diff, height := "-", "-"
+		if p.NetworkStats != nil {
+			diff = p.NetworkStats.NetworkDifficulty.String()
+			height = fmt.Sprint(p.NetworkStats.BlockHeight)
+		}
+		rows = append(rows, []string{p.ID, symbol, algo, hashrate, miners, diff, height, agoString(p.LastPoolBlockTime), percent(p.PoolEffort)})
+	}
+	if len(a.pools) == 0 {
+		return []string{"no pools"}
+	}
+	return table(rows, a.poolSel, true)
+}
+
+func (a *app) renderPool() []string {
+	p := a.pool(a.poolID)
+	if p == nil {
+		return []string{"pool " + a.poolID + " not found"}
+	}
+	var algo string
+	if p.Coin != nil {
+		algo = p.Coin.Algorithm
+	}
+	var lines []string
+	if s := p.PoolStats; s != nil {
+		lines = append(lines, fmt.Sprintf("hashrate %s  miners %d  shares/s %d  effort %s  blocks %d (%d confirmed)  paid %.4f",
+			s.PoolHashrate.Format(algo), s.ConnectedMiners, s.SharesPerSecond, percent(p.PoolEffort),
+			p.TotalBlocks, p.TotalConfirmedBlocks, p.TotalPaid))
+	}
+	if s := p.NetworkStats; s != nil {
+		lines = append(lines, fmt.Sprintf("network %s  difficulty %s  height %d  peers %d  last block %s",
+			s.NetworkHashrate.Format(algo), s.NetworkDifficulty, s.BlockHeight, s.ConnectedPeers, agoString(s.LastNetworkBlockTime)))
+	}
+
+	lines = append(lines, "", bold+"BLOCKS"+reset)
+	rows := [][]string{{"HEIGHT", "STATUS", "PROGRESS", "EFFORT", "REWARD", "MINER", "FOUND"}}
+	for _, b := range a.blocks {
+		rows = append(rows, []string{fmt.Sprint(b.BlockHeight), string(b.Status), percent(b.ConfirmationProgress),
+			percent(b.Effort), fmt.Sprintf("%.4f", b.Reward), b.Miner, agoString(b.Created)})
+	}
+	lines = append(lines, table(rows, -1, true)...)
+
+	lines = append(lines, "", bold+"TOP MINERS"+reset)
+	rows = [][]string{{"MINER", "HASHRATE", "SHARES/S"}}
+	for _, m := range a.miners {
+		rows = append(rows, []string{m.Miner, m.Hashrate.Format(algo), fmt.Sprintf("%.2f", m.SharesPerSecond)})
+	}
+	return append(lines, table(rows, a.minerSel, true)...)
+}
+
+func (a *app) renderMiner(width int) []string {
+	var algo string
+	if p := a.pool(a.poolID); p != nil && p.Coin != nil {
+		algo = p.Coin.Algorithm
+	}
+	var lines []string
+	if m := a.minerStats; m != nil {
+		lines = append(lines, fmt.Sprintf("pending shares %d  pending balance %.8f  paid today %.8f  total paid %.8f  last payment %s",
+			m.PendingShares, m.PendingBalance, m.TodayPaid, m.TotalPaid, agoString(m.LastPayment)))
+	}
+
+	series := workerSeries(a.samples)
+	names := make([]string, 0, len(series))
+	for name := range series {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines = append(lines, "", bold+fmt.Sprintf("WORKERS (%s)", strings.ToLower(a.perfMode))+reset)
+	if len(names) == 0 {
+		return append(lines, "no performance samples")
+	}
+	rows := [][]string{{"WORKER", "HASHRATE", "AVERAGE", ""}}
+	for _, name := range names {
+		v := series[name]
+		label := name
+		if label == "" {
+			label = "(default)"
+		}
+		rows = append(rows, []string{label, miningcore.Hashrate(v[len(v)-1]).Format(algo), miningcore.Hashrate(avg(v)).Format(algo), ""})
+	}
+	cells := table(rows, -1, false)
+	// the sparkline fills the rest of the line
+	spark := width - visibleLen(cells[0]) - 1
+	for i, name := range names {
+		cells[i+1] += sparkline(series[name], spark)
+	}
+	return append(lines, cells...)
+}
+
+// workerSeries returns the hashrate of every worker per sample, oldest first.
+// Workers missing in a sample count as zero.
+func workerSeries(samples []*miningcore.WorkerStats) map[string][]float64 {
+	sorted := append([]*miningcore.WorkerStats(nil), samples...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Created < sorted[j].Created })
+	res := make(map[string][]float64)
+	for i, s := range sorted {
+		for name, w := range s.Workers {
+			if _, ok := res[name]; !ok {
+				res[name] = make([]float64, len(sorted))
+			}
+			if w != nil {
+				res[name][i] = float64(w.Hashrate)
+			}
+		}
+	}
+	return res
+}
+
+// sparkline draws the last width values scaled to their maximum.
+func sparkline(values []float64, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	if len(values) > width {
+		values = values[len(values)-width:]
+	}
+	var max float64
+	for _, v := range values {
+		max = math.Max(max, v)
+	}
+	var sb strings.Builder
+	for _, v := range values {
+		i := 0
+		if max > 0 {
+			i = int(v / max * float64(len(sparks)-1))
+		}
+		sb.WriteRune(sparks[i])
+	}
+	return sb.String()
+}
+
+// table aligns the columns of the rows, the first row is the header.
+// The row with index sel (not counting the header) is highlighted.
+func table(rows [][]string, sel int, trim bool) []string {
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			if n := utf8.RuneCountInString(cell); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+	lines := make([]string, 0, len(rows))
+	for r, row := range rows {
+		var sb strings.Builder
+		for i, cell := range row {
+			sb.WriteString(cell)
+			sb.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)+2))
+		}
+		line := sb.String()
+		if trim {
+			line = strings.TrimRight(line, " ")
+		}
+		switch {
+		case r == 0:
+			line = bold + line + reset
+		case r-1 == sel:
+			line = reverse + line + reset
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// layout puts the body between header and footer and cuts it to the terminal height.
+func layout(header, body, footer []string, height int) []string {
+	space := height - len(header) - len(footer)
+	if space < 0 {
+		space = 0
+	}
+	if len(body) > space {
+		body = body[:space]
+	}
+	lines := append(append([]string(nil), header...), body...)
+	for len(lines)+len(footer) < height {
+		lines = append(lines, "")
+	}
+	return append(lines, footer...)
+}
+
+// screen renders the lines from the top left corner, cut to the terminal width.
+func screen(lines []string, width int) string {
+	var sb strings.Builder
+	sb.WriteString("\x1b[H")
+	for i, line := range lines {
+		if i > 0 {
+			sb.WriteString("\r\n")
+		}
+		sb.WriteString(truncate(line, width))
+		sb.WriteString(reset + "\x1b[K")
+	}
+	sb.WriteString("\x1b[J")
+	return sb.String()
+}
+
+// truncate cuts a line to width visible runes, escape sequences don't count.
+func truncate(s string, width int) string {
+	var sb strings.Builder
+	n := 0
+	for i := 0; i < len(s); {
+		if s[i] == '\x1b' {
+			end := strings.IndexByte(s[i:], 'm')
+			if end < 0 {
+				break
+			}
+			sb.WriteString(s[i : i+end+1])
+			i += end + 1
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if n < width {
+			sb.WriteRune(r)
+			n++
+		}
+		i += size
+	}
+	return sb.String()
+}
+
+func visibleLen(s string) int {
+	n := 0
+	for i := 0; i < len(s); {
+		if s[i] == '\x1b' {
+			end := strings.IndexByte(s[i:], 'm')
+			if end < 0 {
+				break
+			}
+			i += end + 1
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s[i:])
+		n++
+		i += size
+	}
+	return n
+}
+
+func describe(n miningcore.Notification) string {
+	switch m := n.(type) {
+	case *miningcore.BlockFoundMessage:
+		return fmt.Sprintf("block %d found by %s", m.BlockHeight, m.Miner)
+	case *miningcore.BlockUnlockedMessage:
+		return fmt.Sprintf("block %d unlocked as %s, reward %.4f %s", m.BlockHeight, m.BlockType, m.Reward, m.Symbol)
+	case *miningcore.BlockUnlockProgressMessage:
+		return fmt.Sprintf("block %d confirmation %s", m.BlockHeight, percent(m.Progress))
+	case *miningcore.ChainHeightMessage:
+		return fmt.Sprintf("new chain height %d", m.BlockHeight)
+	case *miningcore.PaymentMessage:
+		return fmt.Sprintf("paid %.4f %s to %d miners", m.Amount, m.Symbol, m.RecipientsCount)
+	}
+	return string(n.MessageType())
+}
+
+func agoString(s string) string {
+	if s == "" {
+		return "-"
+	}
+	t, err := miningcore.ParseTime(s)
+	if err != nil {
+		return s
+	}
+	return ago(t)
+}
+
+func ago(t time.Time) string {
+	d := time.Since(t)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds ago", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh%02dm ago", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return fmt.Sprintf("%dd ago", int(d.Hours()/24))
+}
+
+func percent(f float64) string {
+	return fmt.Sprintf("%.1f%%", f*100)
+}
+
+func avg(v []float64) float64 {
+	var sum float64
+	for _, f := range v {
+		sum += f
+	}
+	return sum / float64(len(v))
+}
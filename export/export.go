@@ -0,0 +1,465 @@
+// Package export streams the paged histories of a miningcore pool to CSV or JSON Lines.
+// Checkpoints remember the newest exported records per stream, so repeated exports only
+// append records that were added since.
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Format is an output format.
+type Format string
+
+const (
+	CSV       Format = "csv"
+	JSONLines Format = "jsonl"
+)
+
+// Checkpoint is the position of a stream: the creation time of the newest exported record
+// and the keys of all exported records created at exactly that time.
+type Checkpoint struct {
+	Time time.Time `json:"time"`
+	Keys []string  `json:"keys,omitempty"`
+}
+
+// covers reports whether the record with the given time and key has already been exported.
+// n counts the records with the same time and key, so identical records are told apart.
+func (c Checkpoint) covers(t time.Time, key string, n int) bool {
+	if !t.Equal(c.Time) {
+		return t.Before(c.Time)
+	}
+	for _, k := range c.Keys {
+		if k == key {
+			n--
+		}
+	}
+	return n <= 0
+}
+
+// advance moves the checkpoint to a record written after all records it covers.
+func (c Checkpoint) advance(t time.Time, key string) Checkpoint {
+	if t.After(c.Time) {
+		return Checkpoint{Time: t, Keys: []string{key}}
+	}
+	return Checkpoint{Time: c.Time, Keys: append(append([]string(nil), c.Keys...), key)}
+}
+
+// Checkpoints stores the checkpoint of every stream.
+type Checkpoints struct {
+	mu      sync.Mutex
+	Streams map[string]Checkpoint `json:"streams"`
+}
+
+// LoadCheckpoints reads checkpoints from a JSON file. A missing file yields empty checkpoints.
+func LoadCheckpoints(path string) (*Checkpoints, error) {
+	cp := &Checkpoints{Streams: make(map[string]Checkpoint)}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return cp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, cp); err != nil {
+		return nil, err
+	}
+	if cp.Streams == nil {
+		cp.Streams = make(map[string]Checkpoint)
+	}
+	return cp, nil
+}
+
+// Save writes the checkpoints to a JSON file.
+func (cp *Checkpoints) Save(path string) error {
+	cp.mu.Lock()
+	data, err := json.MarshalIndent(cp, "", "  ")
+	cp.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func (cp *Checkpoints) get(stream string) Checkpoint {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	return cp.Streams[stream]
+}
+
+func (cp *Checkpoints) set(stream string, c Checkpoint) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.Streams[stream] = c
+}
+
+// ExporterOpts are options for the exporter.
+type ExporterOpts func(*Exporter)
+
+// WithFormat sets the output format, the default is CSV.
+func WithFormat(f Format) ExporterOpts {
+	return func(e *Exporter) {
+		e.format = f
+	}
+}
+
+// WithCheckpoints enables incremental exports using the given checkpoints.
+func WithCheckpoints(cp *Checkpoints) ExporterOpts {
+	return func(e *Exporter) {
+		e.checkpoints = cp
+	}
+}
+
+// Exporter writes histories of the miningcore API.
+// Records are written oldest first, so an interrupted export can be resumed from its checkpoints.
+// The CSV header is only written to files that are still empty.
+type Exporter struct {
+	client      *miningcore.Client
+	format      Format
+	checkpoints *Checkpoints
+	now         func() time.Time
+}
+
+// New creates a new exporter.
+func New(c *miningcore.Client, opts ...ExporterOpts) *Exporter {
+	e := &Exporter{
+		client:      c,
+		format:      CSV,
+		checkpoints: &Checkpoints{Streams: make(map[string]Checkpoint)},
+		now:         time.Now,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// PoolBlocks exports the blocks of a pool and returns the number of written records.
+func (e *Exporter) PoolBlocks(ctx context.Context, w io.Writer, id string) (int, error) {
+	return export(ctx, e, w, "blocks/"+id, e.client.PoolBlocksPages(id), blockColumns, func(b *miningcore.Block) string { return b.Created }, blockKey)
+}
+
+// PoolPayments exports the payments of a pool and returns the number of written records.
+func (e *Exporter) PoolPayments(ctx context.Context, w io.Writer, id string) (int, error) {
+	return export(ctx, e, w, "payments/"+id, e.client.PoolPaymentsPages(id), paymentColumns, func(p *miningcore.Payment) string { return p.Created }, paymentKey)
+}
+
+// MinerPayments exports the payments of a miner and returns the number of written records.
+func (e *Exporter) MinerPayments(ctx context.Context, w io.Writer, id, addr string) (int, error) {
+	return export(ctx, e, w, "payments/"+id+"/"+addr, e.client.MinerPaymentsPages(id, addr), paymentColumns, func(p *miningcore.Payment) string { return p.Created }, paymentKey)
+}
+
+// BalanceChanges exports the balance changes of a miner and returns the number of written records.
+func (e *Exporter) BalanceChanges(ctx context.Context, w io.Writer, id, addr string) (int, error) {
+	return export(ctx, e, w, "balancechanges/"+id+"/"+addr, e.client.MinerBalanceChangesPages(id, addr), balanceChangeColumns, func(c *miningcore.BalanceChange) string { return c.Created }, balanceChangeKey)
+}
+
+// DailyEarnings exports the daily earnings of a miner and returns the number of written records.
+// The current day is skipped because its amount still changes.
+func (e *Exporter) DailyEarnings(ctx context.Context, w io.Writer, id, addr string) (int, error) {
+	today := e.now().UTC().Truncate(24 * time.Hour)
+	skip := func(d *miningcore.DailyEarning) bool {
+		t, err := miningcore.ParseTime(d.Date)
+		return err == nil && !t.Before(today)
+	}
+	return export(ctx, e, w, "earnings/"+id+"/"+addr, e.client.MinerDailyEarningsPages(id, addr), dailyEarningColumns, func(d *miningcore.DailyEarning) string { return d.Date }, func(d *miningcore.DailyEarning) string { return d.Date }, skip)
+}
+
+// PoolPerformance exports the performance samples of a pool and returns the number of written records.
+// The params are passed to GetPerformance.
+func (e *Exporter) PoolPerformance(ctx context.Context, w io.Writer, id string, params ...map[string]string) (int, error) {
+	samples, _, err := e.client.GetPerformance(ctx, id, params...)
+	if err != nil {
+		return 0, err
+	}
+	return export(ctx, e, w, "performance/"+id, once(samples), poolPerformanceColumns, func(p *miningcore.PoolPerformance) string { return p.Created }, func(p *miningcore.PoolPerformance) string { return p.Created })
+}
+
+// MinerPerformance exports the performance samples of a miner with one record per worker
+// and returns the number of written records. The params are passed to GetMinerPerformance.
+func (e *Exporter) MinerPerformance(ctx context.Context, w io.Writer, id, addr string, params ...map[string]string) (int, error) {
+	samples, _, err := e.client.GetMinerPerformance(ctx, id, addr, params...)
+	if err != nil {
+		return 0, err
+	}
+	var rows []*WorkerSample
+	for _, s := range samples {
+		workers := make([]string, 0, len(s.Workers))
+		for name := range s.Workers {
+			workers = append(workers, name)
+		}
+		sort.Strings(workers)
+		for _, name := range workers {
+			rows = append(rows, &WorkerSample{Created: s.Created, Worker: name, WorkerPerformanceStats: s.Workers[name]})
+		}
+	}
+	return export(ctx, e, w, "performance/"+id+"/"+addr, once(rows), workerSampleColumns, func(s *WorkerSample) string { return s.Created }, func(s *WorkerSample) string { return s.Worker })
+}
+
+// WorkerSample is a flattened performance sample of a single worker.
+type WorkerSample struct {
+	Created string `json:"created"`
+	Worker  string `json:"worker"`
+	*miningcore.WorkerPerformanceStats
+}
+
+type column[T any] struct {
+	name  string
+	value func(T) any
+}
+
+var blockColumns = []column[*miningcore.Block]{
+	{"created", func(b *miningcore.Block) any { return b.Created }},
+	{"poolId", func(b *miningcore.Block) any { return b.PoolID }},
+	{"blockHeight", func(b *miningcore.Block) any { return b.BlockHeight }},
+	{"networkDifficulty", func(b *miningcore.Block) any { return float64(b.NetworkDifficulty) }},
+	{"status", func(b *miningcore.Block) any { return b.Status }},
+	{"type", func(b *miningcore.Block) any { return b.Type }},
+	{"confirmationProgress", func(b *miningcore.Block) any { return b.ConfirmationProgress }},
+	{"effort", func(b *miningcore.Block) any { return b.Effort }},
+	{"transactionConfirmationData", func(b *miningcore.Block) any { return b.TransactionConfirmationData }},
+	{"reward", func(b *miningcore.Block) any { return b.Reward }},
+	{"infoLink", func(b *miningcore.Block) any { return b.InfoLink }},
+	{"hash", func(b *miningcore.Block) any { return b.Hash }},
+	{"miner", func(b *miningcore.Block) any { return b.Miner }},
+	{"source", func(b *miningcore.Block) any { return b.Source }},
+}
+
+var paymentColumns = []column[*miningcore.Payment]{
+	{"created", func(p *miningcore.Payment) any { return p.Created }},
+	{"coin", func(p *miningcore.Payment) any { return p.Coin }},
+	{"address", func(p *miningcore.Payment) any { return p.Address }},
+	{"amount", func(p *miningcore.Payment) any { return p.Amount }},
+	{"transactionConfirmationData", func(p *miningcore.Payment) any { return p.TransactionConfirmationData }},
+	{"transactionInfoLink", func(p *miningcore.Payment) any { return p.TransactionInfoLink }},
+	{"addressInfoLink", func(p *miningcore.Payment) any { return p.AddressInfoLink }},
+}
+
+var balanceChangeColumns = []column[*miningcore.BalanceChange]{
+	{"created", func(c *miningcore.BalanceChange) any { return c.Created }},
+	{"poolId", func(c *miningcore.BalanceChange) any { return c.PoolID }},
+	{"address", func(c *miningcore.BalanceChange) any { return c.Address }},
+	{"amount", func(c *miningcore.BalanceChange) any { return c.Amount }},
+	{"usage", func(c *miningcore.BalanceChange) any { return c.Usage }},
+}
+
+var dailyEarningColumns = []column[*miningcore.DailyEarning]{
+	{"date", func(d *miningcore.DailyEarning) any { return d.Date }},
+	{"amount", func(d *miningcore.DailyEarning) any { return d.Amount }},
+}
+
+var poolPerformanceColumns = []column[*miningcore.PoolPerformance]{
+	{"created", func(p *miningcore.PoolPerformance) any { return p.Created }},
+	{"poolHashrate", func(p *miningcore.PoolPerformance) any { return float64(p.PoolHashrate) }},
+	{"connectedMiners", func(p *miningcore.PoolPerformance) any { return p.ConnectedMiners }},
+	{"validSharesPerSecond", func(p *miningcore.PoolPerformance) any { return p.ValidSharesPerSecond }},
+	{"networkHashrate", func(p *miningcore.PoolPerformance) any { return float64(p.NetworkHashrate) }},
+	{"networkDifficulty", func(p *miningcore.PoolPerformance) any { return float64(p.NetworkDifficulty) }},
+}
+
+var workerSampleColumns = []column[*WorkerSample]{
+	{"created", func(s *WorkerSample) any { return s.Created }},
+	{"worker", func(s *WorkerSample) any { return s.Worker }},
+	{"hashrate", func(s *WorkerSample) any { return float64(s.Hashrate) }},
+	{"reportedHashrate", func(s *WorkerSample) any { return float64(s.ReportedHashrate) }},
+	{"sharesPerSecond", func(s *WorkerSample) any { return s.SharesPerSecond }},
+}
+
+// export writes all records that are not covered by the checkpoint of the stream, oldest first,
+// and advances the checkpoint with every flushed record. key identifies records created at the same time.
+// Records for which skip returns true are left out.
+func export[T any](ctx context.Context, e *Exporter, w io.Writer, stream string, fetch miningcore.PageFunc[T], columns []column[T], created, key func(T) string, skip ...func(T) bool) (int, error) {
+	type record struct {
+		item T
+		t    time.Time
+	}
+	checkpoint := e.checkpoints.get(stream)
+	// the api lists the records newest first
+	var records []record
+	same := make(map[string]int)
+	err := miningcore.Paginate(ctx, 0, fetch, func(item T) (bool, error) {
+		if len(skip) > 0 && skip[0](item) {
+			return true, nil
+		}
+		t, err := miningcore.ParseTime(created(item))
+		if err != nil {
+			return false, err
+		}
+		if t.Before(checkpoint.Time) {
+			return false, nil
+		}
+		k := key(item)
+		if t.Equal(checkpoint.Time) {
+			same[k]++
+		}
+		if !checkpoint.covers(t, k, same[k]) {
+			records = append(records, record{item, t})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	enc := newEncoder(e.format, w, columns)
+	if empty(w, checkpoint) {
+		if err := enc.header(); err != nil {
+			return 0, err
+		}
+	}
+	var n int
+	for i := len(records) - 1; i >= 0; i-- {
+		r := records[i]
+		if err := enc.write(r.item); err != nil {
+			return n, err
+		}
+		if err := enc.flush(); err != nil {
+			return n, err
+		}
+		n++
+		checkpoint = checkpoint.advance(r.t, key(r.item))
+		e.checkpoints.set(stream, checkpoint)
+	}
+	return n, enc.flush()
+}
+
+// empty reports whether nothing has been written to w yet. Files are checked for their size,
+// other writers are assumed to be empty unless the stream has a checkpoint.
+func empty(w io.Writer, checkpoint Checkpoint) bool {
+	if f, ok := w.(interface{ Stat() (os.FileInfo, error) }); ok {
+		if fi, err := f.Stat(); err == nil && fi.Mode().IsRegular() {
+			return fi.Size() == 0
+		}
+	}
+	return checkpoint.Time.IsZero()
+}
+
+func blockKey(b *miningcore.Block) string {
+	if b.Hash != "" {
+		return b.Hash
+	}
+	return strconv.FormatInt(b.BlockHeight, 10)
+}
+
+func paymentKey(p *miningcore.Payment) string {
+	return p.TransactionConfirmationData + "/" + p.Address
+}
+
+func balanceChangeKey(c *miningcore.BalanceChange) string {
+	return c.Address + "/" + c.Usage + "/" + formatValue(c.Amount)
+}
+
+// once turns an already loaded slice into a PageFunc with a single page.
+func once[T any](items []T) miningcore.PageFunc[T] {
+	return func(ctx context.Context, params map[string]string) ([]T, error) {
+		if params["page"] != "0" {
+			return nil, nil
+		}
+		return items, nil
+	}
+}
+
+type encoder[T any] struct {
+	format  Format
+	columns []column[T]
+	csv     *csv.Writer
+	json    *json.Encoder
+}
+
+func newEncoder[T any](format Format, w io.Writer, columns []column[T]) *encoder[T] {
+	e := &encoder[T]{format: format, columns: columns}
+	if format == JSONLines {
+		e.json = json.NewEncoder(w)
+	} else {
+		e.csv = csv.NewWriter(w)
+	}
+	return e
+}
+
+func (e *encoder[T]) header() error {
+	if e.csv == nil {
+		return nil
+	}
+	names := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		names[i] = c.name
+	}
+	return e.csv.Write(names)
+}
+
+func (e *encoder[T]) write(item T) error {
+	if e.json != nil {
+		row := make(orderedRow, len(e.columns))
+		for i, c := range e.columns {
+			row[i] = field{c.name, c.value(item)}
+		}
+		return e.json.Encode(row)
+	}
+	row := make([]string, len(e.columns))
+	for i, c := range e.columns {
+		row[i] = formatValue(c.value(item))
+	}
+	return e.csv.Write(row)
+}
+
+func (e *encoder[T]) flush() error {
+	if e.csv == nil {
+		return nil
+	}
+	e.csv.Flush()
+	return e.csv.Error()
+}
+
+// orderedRow marshals to a JSON object with the keys in column order.
+type orderedRow []field
+
+type field struct {
+	name  string
+	value any
+}
+
+func (r orderedRow) MarshalJSON() ([]byte, error) {
+	buf := []byte{'{'}
+	for i, kv := range r {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		k, err := json.Marshal(kv.name)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(kv.value)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, k...)
+		buf = append(buf, ':')
+		buf = append(buf, v...)
+	}
+	return append(buf, '}'), nil
+}
+
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
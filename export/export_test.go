@@ -0,0 +1,167 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func testServer(payments *[]*miningcore.Payment) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/pools/eth/payments", func(w http.ResponseWriter, r *http.Request) {
+		var res []*miningcore.Payment
+		if r.URL.Query().Get("page") == "0" {
+			res = *payments
+		}
+		json.NewEncoder(w).Encode(miningcore.PaymentRes{Result: res})
+	})
+	mux.HandleFunc("/api/v2/pools/eth/miners/0xabc/earnings/daily", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(miningcore.DailyEarningRes{Result: []*miningcore.DailyEarning{
+			{Date: "2022-07-03", Amount: 0.1},
+			{Date: "2022-07-02", Amount: 1.5},
+		}})
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestPoolPaymentsCSV(t *testing.T) {
+	payments := []*miningcore.Payment{
+		{Address: "0xabc", Amount: 1.5, TransactionConfirmationData: "0x2", Created: "2022-07-02T00:00:00Z"},
+		{Address: "0xdef", Amount: 0.25, TransactionConfirmationData: "0x1", Created: "2022-07-01T00:00:00Z"},
+	}
+	ts := testServer(&payments)
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	cp, err := LoadCheckpoints(path)
+	assert.NoError(t, err)
+	e := New(miningcore.New(ts.URL), WithCheckpoints(cp))
+
+	var buf bytes.Buffer
+	n, err := e.PoolPayments(context.Background(), &buf, "eth")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, strings.Join([]string{
+		"created,coin,address,amount,transactionConfirmationData,transactionInfoLink,addressInfoLink",
+		"2022-07-01T00:00:00Z,,0xdef,0.25,0x1,,",
+		"2022-07-02T00:00:00Z,,0xabc,1.5,0x2,,",
+		"",
+	}, "\n"), buf.String())
+	assert.NoError(t, cp.Save(path))
+
+	// a payment with the same time as the checkpoint is still exported
+	payments = append([]*miningcore.Payment{
+		{Address: "0xabc", Amount: 2, TransactionConfirmationData: "0x3", Created: "2022-07-03T00:00:00Z"},
+		{Address: "0xdef", Amount: 1, TransactionConfirmationData: "0x2", Created: "2022-07-02T00:00:00Z"},
+	}, payments...)
+	cp, err = LoadCheckpoints(path)
+	assert.NoError(t, err)
+	e = New(miningcore.New(ts.URL), WithCheckpoints(cp))
+	buf.Reset()
+	n, err = e.PoolPayments(context.Background(), &buf, "eth")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "2022-07-02T00:00:00Z,,0xdef,1,0x2,,\n2022-07-03T00:00:00Z,,0xabc,2,0x3,,\n", buf.String())
+}
+
+type failingWriter struct {
+	n int
+}
+
+func (w *failingWriter) Write(p []byte) (int, error) {
+	if w.n == 0 {
+		return 0, errors.New("disk full")
+	}
+	w.n--
+	return len(p), nil
+}
+
+func TestCheckpointAdvancesPerRecord(t *testing.T) {
+	payments := []*miningcore.Payment{
+		{Address: "0xabc", Amount: 3, TransactionConfirmationData: "0x3", Created: "2022-07-03T00:00:00Z"},
+		{Address: "0xabc", Amount: 2, TransactionConfirmationData: "0x2", Created: "2022-07-02T00:00:00Z"},
+		{Address: "0xabc", Amount: 1, TransactionConfirmationData: "0x1", Created: "2022-07-01T00:00:00Z"},
+	}
+	ts := testServer(&payments)
+	defer ts.Close()
+
+	// the writer fails after the first two records
+	e := New(miningcore.New(ts.URL), WithFormat(JSONLines))
+	_, err := e.PoolPayments(context.Background(), &failingWriter{n: 2}, "eth")
+	assert.Error(t, err)
+	assert.Equal(t, Checkpoint{Time: time.Date(2022, 7, 2, 0, 0, 0, 0, time.UTC), Keys: []string{"0x2/0xabc"}}, e.checkpoints.get("payments/eth"))
+
+	var buf bytes.Buffer
+	n, err := e.PoolPayments(context.Background(), &buf, "eth")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Contains(t, buf.String(), `"transactionConfirmationData":"0x3"`)
+}
+
+func TestIdenticalRecordsResume(t *testing.T) {
+	payments := []*miningcore.Payment{
+		{Address: "0xabc", Amount: 1, TransactionConfirmationData: "0x1", Created: "2022-07-01T00:00:00Z"},
+		{Address: "0xabc", Amount: 1, TransactionConfirmationData: "0x1", Created: "2022-07-01T00:00:00Z"},
+	}
+	ts := testServer(&payments)
+	defer ts.Close()
+
+	e := New(miningcore.New(ts.URL), WithFormat(JSONLines))
+	_, err := e.PoolPayments(context.Background(), &failingWriter{n: 1}, "eth")
+	assert.Error(t, err)
+
+	var buf bytes.Buffer
+	n, err := e.PoolPayments(context.Background(), &buf, "eth")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestResumeWritesHeaderOnce(t *testing.T) {
+	var payments []*miningcore.Payment
+	ts := testServer(&payments)
+	defer ts.Close()
+
+	out := filepath.Join(t.TempDir(), "payments.csv")
+	export := func(e *Exporter) {
+		f, err := os.OpenFile(out, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		assert.NoError(t, err)
+		defer f.Close()
+		_, err = e.PoolPayments(context.Background(), f, "eth")
+		assert.NoError(t, err)
+	}
+
+	// the first export has no records and leaves the checkpoint empty
+	cp := &Checkpoints{Streams: make(map[string]Checkpoint)}
+	export(New(miningcore.New(ts.URL), WithCheckpoints(cp)))
+	payments = []*miningcore.Payment{{Address: "0xabc", Amount: 1, TransactionConfirmationData: "0x1", Created: "2022-07-01T00:00:00Z"}}
+	export(New(miningcore.New(ts.URL), WithCheckpoints(cp)))
+
+	data, err := os.ReadFile(out)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, strings.Count(string(data), "created,coin,address"))
+	assert.Equal(t, 2, strings.Count(string(data), "\n"))
+}
+
+func TestDailyEarningsJSONLines(t *testing.T) {
+	ts := testServer(&[]*miningcore.Payment{})
+	defer ts.Close()
+
+	e := New(miningcore.New(ts.URL), WithFormat(JSONLines))
+	e.now = func() time.Time { return time.Date(2022, 7, 3, 12, 0, 0, 0, time.UTC) }
+	var buf bytes.Buffer
+	n, err := e.DailyEarnings(context.Background(), &buf, "eth", "0xabc")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, `{"date":"2022-07-02","amount":1.5}`+"\n", buf.String())
+}
@@ -0,0 +1,296 @@
+// Package feed polls the miningcore API and emits the same typed notifications as the
+// websocket notification stream, for deployments that don't expose /notifications.
+package feed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/internal/blocks"
+	"github.com/stratumfarm/go-miningcore-client/internal/poll"
+)
+
+// paymentPageSize is the page size of payment requests, the default of the api.
+const paymentPageSize = 15
+
+// FeedOpts are options for the feed.
+type FeedOpts func(*Feed)
+
+// WithInterval sets the poll interval.
+func WithInterval(d time.Duration) FeedOpts {
+	return func(f *Feed) {
+		f.interval = d
+	}
+}
+
+// WithErrorHandler sets a function that is called with errors of failed polls in Run.
+func WithErrorHandler(fn func(error)) FeedOpts {
+	return func(f *Feed) {
+		f.onError = fn
+	}
+}
+
+// Feed diff-checks the pool, its latest blocks and its latest payments on an interval.
+// Pending blocks are followed beyond the first page of blocks until they are unlocked, payments
+// are paged back to the newest payment of the last poll.
+// The first poll of a pool only records its state, notifications are emitted for changes afterwards.
+// Hashrate updates are not available through polling and are never emitted.
+type Feed struct {
+	client   *miningcore.Client
+	pools    []string
+	interval time.Duration
+	onError  func(error)
+
+	mu    sync.Mutex
+	state map[string]*poolState
+}
+
+type poolState struct {
+	height   uint64
+	blocks   map[string]*miningcore.Block
+	payments map[string]struct{}
+}
+
+// New creates a new feed for the given pool ids.
+func New(c *miningcore.Client, pools []string, opts ...FeedOpts) *Feed {
+	f := &Feed{
+		client:   c,
+		pools:    pools,
+		interval: time.Second * 30,
+		state:    make(map[string]*poolState),
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// Run polls all pools until the context is canceled and calls fn for every notification.
+func (f *Feed) Run(ctx context.Context, fn func(miningcore.Notification)) error {
+	return poll.Each(ctx, f.interval, f.Poll, fn, f.onError)
+}
+
+// Poll checks all pools once and returns the notifications for everything that changed since the last poll.
+// Pools that fail to load are skipped, the first error is returned along with the notifications of the others.
+func (f *Feed) Poll(ctx context.Context) ([]miningcore.Notification, error) {
+	var msgs []miningcore.Notification
+	var firstErr error
+	for _, id := range f.pools {
+		m, err := f.pollPool(ctx, id)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		msgs = append(msgs, m...)
+	}
+	return msgs, firstErr
+}
+
+func (f *Feed) pollPool(ctx context.Context, id string) ([]miningcore.Notification, error) {
+	pool, _, err := f.client.GetPool(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := f.fetchBlocks(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := f.fetchPayments(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	state, known := f.state[id]
+	if !known {
+		state = &poolState{}
+		f.state[id] = state
+	}
+
+	var symbol, name string
+	if pool.Coin != nil {
+		symbol, name = pool.Coin.Symbol, pool.Coin.Name
+	}
+	var msgs []miningcore.Notification
+
+	if pool.NetworkStats != nil {
+		height := uint64(pool.NetworkStats.BlockHeight)
+		if known && height != state.height {
+			msgs = append(msgs, &miningcore.ChainHeightMessage{
+				BlockMessage: miningcore.BlockMessage{PoolID: id, BlockHeight: height, Symbol: symbol, Name: name},
+			})
+		}
+		state.height = height
+	}
+
+	seenBlocks := make(map[string]*miningcore.Block, len(blocks))
+	for i := len(blocks) - 1; i >= 0; i-- {
+		b := blocks[i]
+		key := blockKey(b)
+		seenBlocks[key] = b
+		if !known {
+			continue
+		}
+		msgs = append(msgs, blockChanges(state.blocks[key], b, symbol, name)...)
+	}
+	state.blocks = seenBlocks
+
+	seenPayments := make(map[string]struct{}, len(payments))
+	var newPayments []*miningcore.Payment
+	for _, p := range payments {
+		key := paymentKey(p)
+		seenPayments[key] = struct{}{}
+		if _, ok := state.payments[key]; known && !ok {
+			newPayments = append(newPayments, p)
+		}
+	}
+	state.payments = seenPayments
+	msgs = append(msgs, paymentMessages(id, symbol, newPayments)...)
+
+	return msgs, nil
+}
+
+// fetchBlocks returns the first page of blocks and as many further pages as needed to find
+// all pending blocks of the last poll, newest first.
+func (f *Feed) fetchBlocks(ctx context.Context, id string) ([]*miningcore.Block, error) {
+	f.mu.Lock()
+	pending := make(map[string]time.Time)
+	if state, ok := f.state[id]; ok {
+		for key, b := range state.blocks {
+			if b.Status != miningcore.BlockPending {
+				continue
+			}
+			created, err := miningcore.ParseTime(b.Created)
+			if err != nil {
+				f.mu.Unlock()
+				return nil, err
+			}
+			pending[key] = created
+		}
+	}
+	f.mu.Unlock()
+
+	return blocks.Follow(ctx, f.client.PoolBlocksPages(id), pending, blockKey)
+}
+
+// fetchPayments returns the payments of a pool, newest first. Pages are fetched until a page
+// contains a payment of the last poll, the older payments were seen before. The first poll of
+// a pool only fetches the first page.
+func (f *Feed) fetchPayments(ctx context.Context, id string) ([]*miningcore.Payment, error) {
+	f.mu.Lock()
+	known := make(map[string]struct{})
+	state, polled := f.state[id]
+	if polled {
+		for key := range state.payments {
+			known[key] = struct{}{}
+		}
+	}
+	f.mu.Unlock()
+
+	fetch := f.client.PoolPaymentsPages(id)
+	var payments []*miningcore.Payment
+	for page := 0; ; page++ {
+		items, err := fetch(ctx, map[string]string{"page": strconv.Itoa(page), "pageSize": strconv.Itoa(paymentPageSize)})
+		last := errors.Is(err, miningcore.ErrLastPage)
+		if err != nil && !last {
+			return nil, err
+		}
+		payments = append(payments, items...)
+		seen := !polled
+		for _, p := range items {
+			if _, ok := known[paymentKey(p)]; ok {
+				seen = true
+			}
+		}
+		if last || seen || len(items) < paymentPageSize {
+			return payments, nil
+		}
+	}
+}
+
+// blockChanges compares the previous and current state of a block.
+func blockChanges(prev, cur *miningcore.Block, symbol, name string) []miningcore.Notification {
+	base := miningcore.BlockMessage{
+		PoolID:      cur.PoolID,
+		BlockHeight: uint64(cur.BlockHeight),
+		Symbol:      symbol,
+		Name:        name,
+	}
+	var msgs []miningcore.Notification
+	if prev == nil {
+		msgs = append(msgs, &miningcore.BlockFoundMessage{
+			BlockMessage: base,
+			Miner:        cur.Miner,
+			Source:       cur.Source,
+		})
+		if cur.Status == miningcore.BlockPending {
+			return msgs
+		}
+	}
+	if prev != nil && prev.Status != miningcore.BlockPending {
+		return msgs
+	}
+
+	switch cur.Status {
+	case miningcore.BlockPending:
+		if prev != nil && cur.ConfirmationProgress != prev.ConfirmationProgress {
+			msgs = append(msgs, &miningcore.BlockUnlockProgressMessage{
+				BlockMessage: base,
+				Progress:     cur.ConfirmationProgress,
+				Effort:       cur.Effort,
+			})
+		}
+	default:
+		blockType := cur.Type
+		if cur.Status == miningcore.BlockOrphaned {
+			blockType = "orphan"
+		}
+		msgs = append(msgs, &miningcore.BlockUnlockedMessage{
+			BlockMessage: base,
+			BlockType:    blockType,
+			BlockHash:    cur.Hash,
+			Reward:       cur.Reward,
+			Effort:       cur.Effort,
+			Miner:        cur.Miner,
+			ExplorerLink: cur.InfoLink,
+		})
+	}
+	return msgs
+}
+
+// paymentMessages groups payments by transaction like miningcore does for its payment notifications.
+func paymentMessages(id, symbol string, payments []*miningcore.Payment) []miningcore.Notification {
+	var msgs []miningcore.Notification
+	byTx := make(map[string]*miningcore.PaymentMessage)
+	for _, p := range payments {
+		msg, ok := byTx[p.TransactionConfirmationData]
+		if !ok {
+			msg = &miningcore.PaymentMessage{
+				PoolID: id,
+				Symbol: symbol,
+				TxIDs:  []string{p.TransactionConfirmationData},
+			}
+			if p.TransactionInfoLink != "" {
+				msg.TxExplorerLinks = []string{p.TransactionInfoLink}
+			}
+			byTx[p.TransactionConfirmationData] = msg
+			msgs = append(msgs, msg)
+		}
+		msg.Amount += p.Amount
+		msg.RecipientsCount++
+	}
+	return msgs
+}
+
+func blockKey(b *miningcore.Block) string {
+	return fmt.Sprintf("%d/%s", b.BlockHeight, b.Created)
+}
+
+func paymentKey(p *miningcore.Payment) string {
+	return fmt.Sprintf("%s/%s/%g/%s", p.TransactionConfirmationData, p.Address, p.Amount, p.Created)
+}
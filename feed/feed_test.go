@@ -0,0 +1,179 @@
+package feed
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/internal/blocks"
+	"github.com/stretchr/testify/assert"
+)
+
+type poolServer struct {
+	mu       sync.Mutex
+	height   int64
+	blocks   []*miningcore.Block
+	payments []*miningcore.Payment
+}
+
+func (s *poolServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/pools/eth", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]any{"pool": miningcore.PoolInfo{
+			ID:           "eth",
+			Coin:         &miningcore.APICoinConfig{Symbol: "ETH", Name: "Ethereum"},
+			NetworkStats: &miningcore.BlockchainStats{BlockHeight: s.height},
+		}})
+	})
+	mux.HandleFunc("/api/v2/pools/eth/blocks", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		json.NewEncoder(w).Encode(miningcore.BlocksRes{Meta: &miningcore.Meta{Success: true}, Result: paged(r, s.blocks)})
+	})
+	mux.HandleFunc("/api/v2/pools/eth/payments", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		json.NewEncoder(w).Encode(miningcore.PaymentRes{Meta: &miningcore.Meta{Success: true}, Result: paged(r, s.payments)})
+	})
+	return mux
+}
+
+// paged returns the page of items requested by the page and pageSize params.
+func paged[T any](r *http.Request, items []T) []T {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	size, err := strconv.Atoi(r.URL.Query().Get("pageSize"))
+	if err != nil {
+		size = 15
+	}
+	start := page * size
+	if start >= len(items) {
+		return nil
+	}
+	items = items[start:]
+	if len(items) > size {
+		items = items[:size]
+	}
+	return items
+}
+
+func (s *poolServer) update(fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fn()
+}
+
+func TestFeed(t *testing.T) {
+	srv := &poolServer{height: 100}
+	ts := httptest.NewServer(srv.handler())
+	defer ts.Close()
+
+	f := New(miningcore.New(ts.URL), []string{"eth"})
+	poll := func() []miningcore.Notification {
+		msgs, err := f.Poll(context.Background())
+		assert.NoError(t, err)
+		return msgs
+	}
+	assert.Empty(t, poll())
+
+	block := &miningcore.Block{PoolID: "eth", BlockHeight: 101, Status: miningcore.BlockPending, Created: "2022-07-01T00:00:00Z", Miner: "0xabc"}
+	srv.update(func() {
+		srv.height = 101
+		srv.blocks = []*miningcore.Block{block}
+	})
+	msgs := poll()
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, miningcore.WsNewChainHeight, msgs[0].MessageType())
+	found := msgs[1].(*miningcore.BlockFoundMessage)
+	assert.Equal(t, uint64(101), found.BlockHeight)
+	assert.Equal(t, "ETH", found.Symbol)
+	assert.Equal(t, "0xabc", found.Miner)
+
+	srv.update(func() { block.ConfirmationProgress = 0.5 })
+	msgs = poll()
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, 0.5, msgs[0].(*miningcore.BlockUnlockProgressMessage).Progress)
+
+	srv.update(func() {
+		block.Status = miningcore.BlockConfirmed
+		block.ConfirmationProgress = 1
+		block.Reward = 2
+		srv.payments = []*miningcore.Payment{
+			{Address: "0xabc", Amount: 1, TransactionConfirmationData: "0xtx"},
+			{Address: "0xdef", Amount: 0.5, TransactionConfirmationData: "0xtx"},
+		}
+	})
+	msgs = poll()
+	assert.Len(t, msgs, 2)
+	unlocked := msgs[0].(*miningcore.BlockUnlockedMessage)
+	assert.Equal(t, 2.0, unlocked.Reward)
+	payment := msgs[1].(*miningcore.PaymentMessage)
+	assert.Equal(t, 1.5, payment.Amount)
+	assert.Equal(t, 2, payment.RecipientsCount)
+	assert.Equal(t, []string{"0xtx"}, payment.TxIDs)
+
+	assert.Empty(t, poll())
+}
+
+func TestFeedPendingBeyondFirstPage(t *testing.T) {
+	block := &miningcore.Block{PoolID: "eth", BlockHeight: 1, Status: miningcore.BlockPending, Created: "2022-07-01T00:00:00Z"}
+	srv := &poolServer{height: 100, blocks: []*miningcore.Block{block}}
+	ts := httptest.NewServer(srv.handler())
+	defer ts.Close()
+
+	f := New(miningcore.New(ts.URL), []string{"eth"})
+	_, err := f.Poll(context.Background())
+	assert.NoError(t, err)
+
+	// the pending block moves to the second page while it is unlocked
+	srv.update(func() {
+		block.Status = miningcore.BlockConfirmed
+		for i := 0; i < blocks.PageSize; i++ {
+			created := time.Date(2022, 7, 2, i, 0, 0, 0, time.UTC).Format(time.RFC3339)
+			srv.blocks = append([]*miningcore.Block{{PoolID: "eth", BlockHeight: int64(i + 2), Status: miningcore.BlockConfirmed, Created: created}}, srv.blocks...)
+		}
+	})
+	msgs, err := f.Poll(context.Background())
+	assert.NoError(t, err)
+	var unlocked []uint64
+	for _, m := range msgs {
+		if u, ok := m.(*miningcore.BlockUnlockedMessage); ok {
+			unlocked = append(unlocked, u.BlockHeight)
+		}
+	}
+	assert.Contains(t, unlocked, uint64(1))
+}
+
+func TestFeedPaymentsBeyondFirstPage(t *testing.T) {
+	srv := &poolServer{height: 100, payments: []*miningcore.Payment{
+		{Address: "0xabc", Amount: 1, TransactionConfirmationData: "0x0", Created: "2022-07-01T00:00:00Z"},
+	}}
+	ts := httptest.NewServer(srv.handler())
+	defer ts.Close()
+
+	f := New(miningcore.New(ts.URL), []string{"eth"})
+	_, err := f.Poll(context.Background())
+	assert.NoError(t, err)
+
+	// a payout run with more payments than fit on a page
+	srv.update(func() {
+		for i := 0; i < 2*paymentPageSize; i++ {
+			srv.payments = append([]*miningcore.Payment{{Address: "0xa" + strconv.Itoa(i), Amount: 1, TransactionConfirmationData: "0x1", Created: "2022-07-02T00:00:00Z"}}, srv.payments...)
+		}
+	})
+	msgs, err := f.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Equal(t, 2*paymentPageSize, msgs[0].(*miningcore.PaymentMessage).RecipientsCount)
+
+	msgs, err = f.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, msgs)
+}
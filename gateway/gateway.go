@@ -0,0 +1,113 @@
+// Package gateway exposes the miningcore api as a GraphQL schema. Nested miner lookups of a
+// query are batched into concurrent GetMiner calls.
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// GatewayOpts are options for the gateway.
+type GatewayOpts func(*Gateway)
+
+// WithConcurrency sets the maximum number of concurrent miner lookups per query.
+func WithConcurrency(n int) GatewayOpts {
+	return func(g *Gateway) {
+		g.concurrency = n
+	}
+}
+
+// Gateway resolves GraphQL queries with the client.
+type Gateway struct {
+	client      *miningcore.Client
+	concurrency int
+	schema      graphql.Schema
+}
+
+// New creates a new gateway.
+func New(c *miningcore.Client, opts ...GatewayOpts) (*Gateway, error) {
+	g := &Gateway{client: c, concurrency: 8}
+	for _, opt := range opts {
+		opt(g)
+	}
+	schema, err := newSchema()
+	if err != nil {
+		return nil, err
+	}
+	g.schema = schema
+	return g, nil
+}
+
+// Schema returns the GraphQL schema.
+func (g *Gateway) Schema() graphql.Schema {
+	return g.schema
+}
+
+// Do executes a query.
+func (g *Gateway) Do(ctx context.Context, query string, variables map[string]interface{}, operation string) *graphql.Result {
+	ctx = context.WithValue(ctx, stateKey{}, &state{
+		client: g.client,
+		miners: newMinerLoader(ctx, g.client, g.concurrency),
+	})
+	return graphql.Do(graphql.Params{
+		Schema:         g.schema,
+		RequestString:  query,
+		VariableValues: variables,
+		OperationName:  operation,
+		Context:        ctx,
+	})
+}
+
+type request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// ServeHTTP serves queries sent as json POST body or as query parameters of GET requests.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		q := r.URL.Query()
+		req.Query = q.Get("query")
+		req.OperationName = q.Get("operationName")
+		if v := q.Get("variables"); v != "" {
+			if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+				http.Error(w, "invalid variables", http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if req.Query == "" {
+		http.Error(w, "missing query", http.StatusBadRequest)
+		return
+	}
+	res := g.Do(r.Context(), req.Query, req.Variables, req.OperationName)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(res)
+}
+
+type stateKey struct{}
+
+// state is the per query state of the resolvers.
+type state struct {
+	client *miningcore.Client
+	miners *minerLoader
+}
+
+func stateOf(ctx context.Context) *state {
+	return ctx.Value(stateKey{}).(*state)
+}
@@ -0,0 +1,129 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+type apiServer struct {
+	minerCalls int32
+	active     int32
+	maxActive  int32
+}
+
+func (s *apiServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 5 && parts[3] == "miners":
+		atomic.AddInt32(&s.minerCalls, 1)
+		n := atomic.AddInt32(&s.active, 1)
+		for {
+			max := atomic.LoadInt32(&s.maxActive)
+			if n <= max || atomic.CompareAndSwapInt32(&s.maxActive, max, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		atomic.AddInt32(&s.active, -1)
+		json.NewEncoder(w).Encode(miningcore.MinerStats{
+			PendingBalance: float64(len(parts[4])),
+			Performance: &miningcore.WorkerStats{Workers: map[string]*miningcore.WorkerPerformanceStats{
+				"rig2": {Hashrate: 2},
+				"rig1": {Hashrate: 1},
+			}},
+		})
+	case len(parts) == 5 && parts[4] == "blocks":
+		json.NewEncoder(w).Encode(miningcore.BlocksRes{Result: []*miningcore.Block{
+			{PoolID: "eth", BlockHeight: 3, Miner: "a", Status: miningcore.BlockPending},
+			{PoolID: "eth", BlockHeight: 2, Miner: "bb", Status: miningcore.BlockConfirmed},
+			{PoolID: "eth", BlockHeight: 1, Miner: "a", Status: miningcore.BlockConfirmed},
+		}})
+	case len(parts) == 5 && parts[4] == "payments":
+		json.NewEncoder(w).Encode(miningcore.PaymentRes{Result: []*miningcore.Payment{{Address: "ccc", Amount: 1.5}}})
+	default:
+		json.NewEncoder(w).Encode(map[string]any{"pool": miningcore.PoolInfo{
+			ID:        "eth",
+			Coin:      &miningcore.APICoinConfig{Symbol: "ETH", Family: miningcore.FamilyEthereum},
+			PoolStats: &miningcore.PoolStats{PoolHashrate: 1e9},
+		}})
+	}
+}
+
+const dashboardQuery = `query Dashboard($id: String!) {
+	pool(id: $id) {
+		id
+		coin { symbol family }
+		poolHashrate
+		networkHashrate
+		blocks(pageSize: 3) {
+			blockHeight
+			status
+			minerStats { address pendingBalance hashrate workers { name hashrate } }
+		}
+		payments { amount minerStats { address pendingBalance } }
+	}
+}`
+
+func TestGateway(t *testing.T) {
+	api := &apiServer{}
+	ts := httptest.NewServer(api)
+	defer ts.Close()
+	g, err := New(miningcore.New(ts.URL))
+	assert.NoError(t, err)
+
+	res := g.Do(context.Background(), dashboardQuery, map[string]interface{}{"id": "eth"}, "")
+	assert.Empty(t, res.Errors)
+	b, err := json.Marshal(res.Data)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"pool": {
+		"id": "eth",
+		"coin": {"symbol": "ETH", "family": "ethereum"},
+		"poolHashrate": 1e9,
+		"networkHashrate": null,
+		"blocks": [
+			{"blockHeight": 3, "status": "pending", "minerStats": {"address": "a", "pendingBalance": 1, "hashrate": 3, "workers": [{"name": "rig1", "hashrate": 1}, {"name": "rig2", "hashrate": 2}]}},
+			{"blockHeight": 2, "status": "confirmed", "minerStats": {"address": "bb", "pendingBalance": 2, "hashrate": 3, "workers": [{"name": "rig1", "hashrate": 1}, {"name": "rig2", "hashrate": 2}]}},
+			{"blockHeight": 1, "status": "confirmed", "minerStats": {"address": "a", "pendingBalance": 1, "hashrate": 3, "workers": [{"name": "rig1", "hashrate": 1}, {"name": "rig2", "hashrate": 2}]}}
+		],
+		"payments": [{"amount": 1.5, "minerStats": {"address": "ccc", "pendingBalance": 3}}]
+	}}`, string(b))
+
+	// every miner is fetched once and all of them concurrently
+	assert.Equal(t, int32(3), atomic.LoadInt32(&api.minerCalls))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&api.maxActive))
+}
+
+func TestHandler(t *testing.T) {
+	ts := httptest.NewServer(&apiServer{})
+	defer ts.Close()
+	g, err := New(miningcore.New(ts.URL), WithConcurrency(1))
+	assert.NoError(t, err)
+
+	body, _ := json.Marshal(map[string]any{"query": `{ miner(pool: "eth", address: "abcd") { pendingBalance } }`})
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body)))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"data": {"miner": {"pendingBalance": 4}}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	g.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql?query="+url.QueryEscape(`{ pool(id: "eth") { id } }`), nil))
+	assert.JSONEq(t, `{"data": {"pool": {"id": "eth"}}}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	g.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/graphql", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	res := g.Do(context.Background(), `{ pool { id } }`, nil, "")
+	assert.NotEmpty(t, res.Errors)
+}
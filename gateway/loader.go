@@ -0,0 +1,83 @@
+package gateway
+
+import (
+	"context"
+	"sync"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+type minerKey struct {
+	pool    string
+	address string
+}
+
+type minerResult struct {
+	done  chan struct{}
+	stats *miningcore.MinerStats
+	err   error
+}
+
+// minerLoader batches the miner lookups of a single query. Resolvers queue their miners and
+// return thunks, the first thunk that is evaluated fetches all queued miners concurrently.
+type minerLoader struct {
+	ctx         context.Context
+	client      *miningcore.Client
+	concurrency int
+
+	mu      sync.Mutex
+	results map[minerKey]*minerResult
+	pending []minerKey
+}
+
+func newMinerLoader(ctx context.Context, c *miningcore.Client, concurrency int) *minerLoader {
+	return &minerLoader{
+		ctx:         ctx,
+		client:      c,
+		concurrency: concurrency,
+		results:     make(map[minerKey]*minerResult),
+	}
+}
+
+// load queues a miner and returns a thunk that resolves to its stats.
+func (l *minerLoader) load(pool, address string) func() (interface{}, error) {
+	key := minerKey{pool, address}
+	l.mu.Lock()
+	r, ok := l.results[key]
+	if !ok {
+		r = &minerResult{done: make(chan struct{})}
+		l.results[key] = r
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.dispatch()
+		<-r.done
+		if r.err != nil {
+			return nil, r.err
+		}
+		return &miner{pool: pool, address: address, MinerStats: r.stats}, nil
+	}
+}
+
+// dispatch fetches all queued miners.
+func (l *minerLoader) dispatch() {
+	l.mu.Lock()
+	batch := l.pending
+	l.pending = nil
+	l.mu.Unlock()
+
+	sem := make(chan struct{}, l.concurrency)
+	for _, key := range batch {
+		l.mu.Lock()
+		r := l.results[key]
+		l.mu.Unlock()
+		sem <- struct{}{}
+		go func(key minerKey, r *minerResult) {
+			defer func() { <-sem }()
+			r.stats, _, r.err = l.client.GetMiner(l.ctx, key.pool, key.address)
+			close(r.done)
+		}(key, r)
+	}
+}
@@ -0,0 +1,418 @@
+package gateway
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// miner is the source of the Miner type.
+type miner struct {
+	pool    string
+	address string
+	*miningcore.MinerStats
+}
+
+// minerSummary is the source of the MinerSummary type.
+type minerSummary struct {
+	pool string
+	*miningcore.MinerPerformanceStats
+}
+
+// payment is the source of the Payment type, payments don't carry their pool.
+type payment struct {
+	pool string
+	*miningcore.Payment
+}
+
+func payments(pool string, res *miningcore.PaymentRes) []payment {
+	list := make([]payment, len(res.Result))
+	for i, p := range res.Result {
+		list[i] = payment{pool: pool, Payment: p}
+	}
+	return list
+}
+
+// worker is the source of the Worker type.
+type worker struct {
+	name string
+	*miningcore.WorkerPerformanceStats
+}
+
+// field creates a field that resolves from a source of type T.
+func field[T any](typ graphql.Output, fn func(T) interface{}) *graphql.Field {
+	return &graphql.Field{
+		Type: typ,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return fn(p.Source.(T)), nil
+		},
+	}
+}
+
+var pageArgs = graphql.FieldConfigArgument{
+	"page":     &graphql.ArgumentConfig{Type: graphql.Int},
+	"pageSize": &graphql.ArgumentConfig{Type: graphql.Int},
+}
+
+// pageParams converts the paging arguments into request params.
+func pageParams(args map[string]interface{}) map[string]string {
+	p := make(map[string]string)
+	for _, k := range []string{"page", "pageSize"} {
+		if v, ok := args[k].(int); ok {
+			p[k] = strconv.Itoa(v)
+		}
+	}
+	return p
+}
+
+func newSchema() (graphql.Schema, error) {
+	var minerType *graphql.Object
+
+	minerStats := func(typ *graphql.Object, pool, address func(interface{}) string) *graphql.Field {
+		return &graphql.Field{
+			Type: typ,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				addr := address(p.Source)
+				if addr == "" {
+					return nil, nil
+				}
+				return stateOf(p.Context).miners.load(pool(p.Source), addr), nil
+			},
+		}
+	}
+
+	coinType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Coin",
+		Fields: graphql.Fields{
+			"type":      field(graphql.String, func(c *miningcore.APICoinConfig) interface{} { return c.Type }),
+			"name":      field(graphql.String, func(c *miningcore.APICoinConfig) interface{} { return c.Name }),
+			"symbol":    field(graphql.String, func(c *miningcore.APICoinConfig) interface{} { return c.Symbol }),
+			"family":    field(graphql.String, func(c *miningcore.APICoinConfig) interface{} { return string(c.Family) }),
+			"algorithm": field(graphql.String, func(c *miningcore.APICoinConfig) interface{} { return c.Algorithm }),
+			"website":   field(graphql.String, func(c *miningcore.APICoinConfig) interface{} { return c.Website }),
+		},
+	})
+
+	paymentProcessingType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PaymentProcessing",
+		Fields: graphql.Fields{
+			"enabled":        field(graphql.Boolean, func(c *miningcore.APIPoolPaymentProcessingConfig) interface{} { return c.Enabled }),
+			"minimumPayment": field(graphql.Float, func(c *miningcore.APIPoolPaymentProcessingConfig) interface{} { return c.MinimumPayment }),
+			"payoutScheme":   field(graphql.String, func(c *miningcore.APIPoolPaymentProcessingConfig) interface{} { return string(c.PayoutScheme) }),
+		},
+	})
+
+	workerType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Worker",
+		Fields: graphql.Fields{
+			"name":             field(graphql.String, func(w worker) interface{} { return w.name }),
+			"hashrate":         field(graphql.Float, func(w worker) interface{} { return float64(w.Hashrate) }),
+			"reportedHashrate": field(graphql.Float, func(w worker) interface{} { return float64(w.ReportedHashrate) }),
+			"sharesPerSecond":  field(graphql.Float, func(w worker) interface{} { return w.SharesPerSecond }),
+		},
+	})
+
+	workerSampleType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "WorkerSample",
+		Fields: graphql.Fields{
+			"created":  field(graphql.String, func(s *miningcore.WorkerStats) interface{} { return s.Created }),
+			"hashrate": field(graphql.Float, func(s *miningcore.WorkerStats) interface{} { return float64(totalHashrate(s)) }),
+			"workers":  field(graphql.NewList(workerType), func(s *miningcore.WorkerStats) interface{} { return workers(s) }),
+		},
+	})
+
+	poolPerformanceType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PoolPerformance",
+		Fields: graphql.Fields{
+			"created":              field(graphql.String, func(p *miningcore.PoolPerformance) interface{} { return p.Created }),
+			"poolHashrate":         field(graphql.Float, func(p *miningcore.PoolPerformance) interface{} { return float64(p.PoolHashrate) }),
+			"connectedMiners":      field(graphql.Int, func(p *miningcore.PoolPerformance) interface{} { return int(p.ConnectedMiners) }),
+			"validSharesPerSecond": field(graphql.Int, func(p *miningcore.PoolPerformance) interface{} { return int(p.ValidSharesPerSecond) }),
+			"networkHashrate":      field(graphql.Float, func(p *miningcore.PoolPerformance) interface{} { return float64(p.NetworkHashrate) }),
+			"networkDifficulty":    field(graphql.Float, func(p *miningcore.PoolPerformance) interface{} { return float64(p.NetworkDifficulty) }),
+		},
+	})
+
+	dailyEarningType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "DailyEarning",
+		Fields: graphql.Fields{
+			"date":   field(graphql.String, func(e *miningcore.DailyEarning) interface{} { return e.Date }),
+			"amount": field(graphql.Float, func(e *miningcore.DailyEarning) interface{} { return e.Amount }),
+		},
+	})
+
+	// payments and miners reference each other, the payment fields are created once the miner type exists
+	paymentType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Payment",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"poolId":                      field(graphql.String, func(p payment) interface{} { return p.pool }),
+				"address":                     field(graphql.String, func(p payment) interface{} { return p.Address }),
+				"amount":                      field(graphql.Float, func(p payment) interface{} { return p.Amount }),
+				"transactionConfirmationData": field(graphql.String, func(p payment) interface{} { return p.TransactionConfirmationData }),
+				"transactionInfoLink":         field(graphql.String, func(p payment) interface{} { return p.TransactionInfoLink }),
+				"created":                     field(graphql.String, func(p payment) interface{} { return p.Created }),
+				"minerStats": minerStats(minerType,
+					func(s interface{}) string { return s.(payment).pool },
+					func(s interface{}) string { return s.(payment).Address },
+				),
+			}
+		}),
+	})
+
+	minerType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Miner",
+		Fields: graphql.Fields{
+			"pool":            field(graphql.String, func(m *miner) interface{} { return m.pool }),
+			"address":         field(graphql.String, func(m *miner) interface{} { return m.address }),
+			"pendingShares":   field(graphql.Float, func(m *miner) interface{} { return float64(m.PendingShares) }),
+			"pendingBalance":  field(graphql.Float, func(m *miner) interface{} { return m.PendingBalance }),
+			"totalPaid":       field(graphql.Float, func(m *miner) interface{} { return m.TotalPaid }),
+			"todayPaid":       field(graphql.Float, func(m *miner) interface{} { return m.TodayPaid }),
+			"lastPayment":     field(graphql.String, func(m *miner) interface{} { return m.LastPayment }),
+			"lastPaymentLink": field(graphql.String, func(m *miner) interface{} { return m.LastPaymentLink }),
+			"hashrate":        field(graphql.Float, func(m *miner) interface{} { return float64(totalHashrate(m.Performance)) }),
+			"workers":         field(graphql.NewList(workerType), func(m *miner) interface{} { return workers(m.Performance) }),
+			"performance": {
+				Type: graphql.NewList(workerSampleType),
+				Args: graphql.FieldConfigArgument{
+					"mode": &graphql.ArgumentConfig{Type: graphql.String, Description: "Hour, Day or Month"},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					m := p.Source.(*miner)
+					params := make(map[string]string)
+					if mode, ok := p.Args["mode"].(string); ok {
+						params["mode"] = mode
+					}
+					res, _, err := stateOf(p.Context).client.GetMinerPerformance(p.Context, m.pool, m.address, params)
+					return res, err
+				},
+			},
+			"payments": {
+				Type: graphql.NewList(paymentType),
+				Args: pageArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					m := p.Source.(*miner)
+					res, _, err := stateOf(p.Context).client.GetMinerPayments(p.Context, m.pool, m.address, pageParams(p.Args))
+					if err != nil {
+						return nil, err
+					}
+					return payments(m.pool, res), nil
+				},
+			},
+			"dailyEarnings": {
+				Type: graphql.NewList(dailyEarningType),
+				Args: pageArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					m := p.Source.(*miner)
+					res, _, err := stateOf(p.Context).client.GetMinerDailyEarnings(p.Context, m.pool, m.address, pageParams(p.Args))
+					if err != nil {
+						return nil, err
+					}
+					return res.Result, nil
+				},
+			},
+		},
+	})
+
+	blockMiner := minerStats(minerType,
+		func(s interface{}) string { return s.(*miningcore.Block).PoolID },
+		func(s interface{}) string { return s.(*miningcore.Block).Miner },
+	)
+	blockType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Fields: graphql.Fields{
+			"poolId":                      field(graphql.String, func(b *miningcore.Block) interface{} { return b.PoolID }),
+			"blockHeight":                 field(graphql.Float, func(b *miningcore.Block) interface{} { return float64(b.BlockHeight) }),
+			"networkDifficulty":           field(graphql.Float, func(b *miningcore.Block) interface{} { return float64(b.NetworkDifficulty) }),
+			"status":                      field(graphql.String, func(b *miningcore.Block) interface{} { return string(b.Status) }),
+			"type":                        field(graphql.String, func(b *miningcore.Block) interface{} { return b.Type }),
+			"confirmationProgress":        field(graphql.Float, func(b *miningcore.Block) interface{} { return b.ConfirmationProgress }),
+			"effort":                      field(graphql.Float, func(b *miningcore.Block) interface{} { return b.Effort }),
+			"transactionConfirmationData": field(graphql.String, func(b *miningcore.Block) interface{} { return b.TransactionConfirmationData }),
+			"reward":                      field(graphql.Float, func(b *miningcore.Block) interface{} { return b.Reward }),
+			"infoLink":                    field(graphql.String, func(b *miningcore.Block) interface{} { return b.InfoLink }),
+			"hash":                        field(graphql.String, func(b *miningcore.Block) interface{} { return b.Hash }),
+			"miner":                       field(graphql.String, func(b *miningcore.Block) interface{} { return b.Miner }),
+			"source":                      field(graphql.String, func(b *miningcore.Block) interface{} { return b.Source }),
+			"created":                     field(graphql.String, func(b *miningcore.Block) interface{} { return b.Created }),
+			"minerStats":                  blockMiner,
+		},
+	})
+
+	summaryMiner := minerStats(minerType,
+		func(s interface{}) string { return s.(minerSummary).pool },
+		func(s interface{}) string { return s.(minerSummary).Miner },
+	)
+	minerSummaryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "MinerSummary",
+		Fields: graphql.Fields{
+			"miner":           field(graphql.String, func(m minerSummary) interface{} { return m.Miner }),
+			"hashrate":        field(graphql.Float, func(m minerSummary) interface{} { return float64(m.Hashrate) }),
+			"sharesPerSecond": field(graphql.Float, func(m minerSummary) interface{} { return m.SharesPerSecond }),
+			"stats":           summaryMiner,
+		},
+	})
+
+	summaries := func(pool string, miners []*miningcore.MinerPerformanceStats) []minerSummary {
+		res := make([]minerSummary, len(miners))
+		for i, m := range miners {
+			res[i] = minerSummary{pool: pool, MinerPerformanceStats: m}
+		}
+		return res
+	}
+
+	poolType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Pool",
+		Fields: graphql.Fields{
+			"id":                field(graphql.String, func(p *miningcore.PoolInfo) interface{} { return p.ID }),
+			"coin":              field(coinType, func(p *miningcore.PoolInfo) interface{} { return p.Coin }),
+			"paymentProcessing": field(paymentProcessingType, func(p *miningcore.PoolInfo) interface{} { return p.PaymentProcessing }),
+			"poolFeePercent":    field(graphql.Float, func(p *miningcore.PoolInfo) interface{} { return p.PoolFeePercent }),
+			"address":           field(graphql.String, func(p *miningcore.PoolInfo) interface{} { return p.Address }),
+			"addressInfoLink":   field(graphql.String, func(p *miningcore.PoolInfo) interface{} { return p.AddressInfoLink }),
+			"totalPaid":         field(graphql.Float, func(p *miningcore.PoolInfo) interface{} { return p.TotalPaid }),
+			"totalBlocks":       field(graphql.Int, func(p *miningcore.PoolInfo) interface{} { return int(p.TotalBlocks) }),
+			"lastPoolBlockTime": field(graphql.String, func(p *miningcore.PoolInfo) interface{} { return p.LastPoolBlockTime }),
+			"poolEffort":        field(graphql.Float, func(p *miningcore.PoolInfo) interface{} { return p.PoolEffort }),
+			"poolHashrate": field(graphql.Float, func(p *miningcore.PoolInfo) interface{} {
+				if p.PoolStats == nil {
+					return nil
+				}
+				return float64(p.PoolStats.PoolHashrate)
+			}),
+			"connectedMiners": field(graphql.Int, func(p *miningcore.PoolInfo) interface{} {
+				if p.PoolStats == nil {
+					return nil
+				}
+				return int(p.PoolStats.ConnectedMiners)
+			}),
+			"networkHashrate": field(graphql.Float, func(p *miningcore.PoolInfo) interface{} {
+				if p.NetworkStats == nil {
+					return nil
+				}
+				return float64(p.NetworkStats.NetworkHashrate)
+			}),
+			"networkDifficulty": field(graphql.Float, func(p *miningcore.PoolInfo) interface{} {
+				if p.NetworkStats == nil {
+					return nil
+				}
+				return float64(p.NetworkStats.NetworkDifficulty)
+			}),
+			"blockHeight": field(graphql.Float, func(p *miningcore.PoolInfo) interface{} {
+				if p.NetworkStats == nil {
+					return nil
+				}
+				return float64(p.NetworkStats.BlockHeight)
+			}),
+			"topMiners": field(graphql.NewList(minerSummaryType), func(p *miningcore.PoolInfo) interface{} {
+				return summaries(p.ID, p.TopMiners)
+			}),
+			"blocks": {
+				Type: graphql.NewList(blockType),
+				Args: pageArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pool := p.Source.(*miningcore.PoolInfo)
+					res, _, err := stateOf(p.Context).client.GetPoolBlocks(p.Context, pool.ID, pageParams(p.Args))
+					if err != nil {
+						return nil, err
+					}
+					return res.Result, nil
+				},
+			},
+			"payments": {
+				Type: graphql.NewList(paymentType),
+				Args: pageArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pool := p.Source.(*miningcore.PoolInfo)
+					res, _, err := stateOf(p.Context).client.GetPoolPayments(p.Context, pool.ID, pageParams(p.Args))
+					if err != nil {
+						return nil, err
+					}
+					return payments(pool.ID, res), nil
+				},
+			},
+			"miners": {
+				Type: graphql.NewList(minerSummaryType),
+				Args: pageArgs,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pool := p.Source.(*miningcore.PoolInfo)
+					res, _, err := stateOf(p.Context).client.GetMiners(p.Context, pool.ID, pageParams(p.Args))
+					if err != nil {
+						return nil, err
+					}
+					return summaries(pool.ID, res), nil
+				},
+			},
+			"performance": {
+				Type: graphql.NewList(poolPerformanceType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					pool := p.Source.(*miningcore.PoolInfo)
+					res, _, err := stateOf(p.Context).client.GetPerformance(p.Context, pool.ID)
+					return res, err
+				},
+			},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"pools": {
+				Type: graphql.NewList(poolType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					res, _, err := stateOf(p.Context).client.GetPools(p.Context)
+					return res, err
+				},
+			},
+			"pool": {
+				Type: poolType,
+				Args: graphql.FieldConfigArgument{
+					"id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					res, _, err := stateOf(p.Context).client.GetPool(p.Context, p.Args["id"].(string))
+					return res, err
+				},
+			},
+			"miner": {
+				Type: minerType,
+				Args: graphql.FieldConfigArgument{
+					"pool":    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return stateOf(p.Context).miners.load(p.Args["pool"].(string), p.Args["address"].(string)), nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{Query: queryType})
+}
+
+func totalHashrate(s *miningcore.WorkerStats) miningcore.Hashrate {
+	if s == nil {
+		return 0
+	}
+	var sum miningcore.Hashrate
+	for _, w := range s.Workers {
+		sum += w.Hashrate
+	}
+	return sum
+}
+
+// workers returns the workers of a sample ordered by name.
+func workers(s *miningcore.WorkerStats) []worker {
+	if s == nil {
+		return nil
+	}
+	res := make([]worker, 0, len(s.Workers))
+	for name, w := range s.Workers {
+		res = append(res, worker{name: name, WorkerPerformanceStats: w})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].name < res[j].name })
+	return res
+}
@@ -0,0 +1,395 @@
+// Package health checks a miningcore instance end to end: the API, the chain state of every
+// pool, payment processing and the reachability of the stratum ports.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Status is the result of a check, its values match the Nagios plugin exit codes.
+type Status int
+
+const (
+	OK Status = iota
+	Warning
+	Critical
+	Unknown
+)
+
+func (s Status) String() string {
+	switch s {
+	case OK:
+		return "OK"
+	case Warning:
+		return "WARNING"
+	case Critical:
+		return "CRITICAL"
+	}
+	return "UNKNOWN"
+}
+
+// severity orders the statuses from OK to Critical, unknown results are worse than warnings.
+func (s Status) severity() int {
+	switch s {
+	case Unknown:
+		return 2
+	case Critical:
+		return 3
+	}
+	return int(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Status) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Result is the outcome of a single check.
+type Result struct {
+	Name    string `json:"name"`
+	Pool    string `json:"pool,omitempty"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+}
+
+// Report is the outcome of all checks, Status is the most severe status of all results.
+type Report struct {
+	Time    time.Time `json:"time"`
+	Status  Status    `json:"status"`
+	Results []Result  `json:"results"`
+}
+
+// ExitCode returns the Nagios plugin exit code of the report.
+func (r *Report) ExitCode() int {
+	return int(r.Status)
+}
+
+// WriteText writes the report in the Nagios plugin output format, a summary line followed by
+// one line per check.
+func (r *Report) WriteText(w io.Writer) error {
+	var failed int
+	for _, res := range r.Results {
+		if res.Status != OK {
+			failed++
+		}
+	}
+	if _, err := fmt.Fprintf(w, "MININGCORE %s - %d of %d checks failed\n", r.Status, failed, len(r.Results)); err != nil {
+		return err
+	}
+	for _, res := range r.Results {
+		name := res.Name
+		if res.Pool != "" {
+			name = res.Pool + "/" + res.Name
+		}
+		if _, err := fmt.Fprintf(w, "[%s] %s: %s\n", res.Status, name, res.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Report) add(pool, name string, status Status, format string, args ...any) {
+	r.Results = append(r.Results, Result{Name: name, Pool: pool, Status: status, Message: fmt.Sprintf(format, args...)})
+	if status.severity() > r.Status.severity() {
+		r.Status = status
+	}
+}
+
+// CheckerOpts are options for the checker.
+type CheckerOpts func(*Checker)
+
+// WithPools limits the checks to the given pools, by default all pools are checked.
+func WithPools(ids ...string) CheckerOpts {
+	return func(c *Checker) {
+		c.pools = ids
+	}
+}
+
+// WithMaxBlockAge sets how old the last network block of a pool may be.
+func WithMaxBlockAge(d time.Duration) CheckerOpts {
+	return func(c *Checker) {
+		c.maxBlockAge = d
+	}
+}
+
+// WithPoolMaxBlockAge overrides the maximum block age for a single pool, e.g. for coins with long block times.
+func WithPoolMaxBlockAge(id string, d time.Duration) CheckerOpts {
+	return func(c *Checker) {
+		c.poolBlockAge[id] = d
+	}
+}
+
+// WithoutPayments sets pools that are expected to run without payment processing.
+func WithoutPayments(ids ...string) CheckerOpts {
+	return func(c *Checker) {
+		for _, id := range ids {
+			c.noPayments[id] = true
+		}
+	}
+}
+
+// WithStratumHost sets the host the stratum ports are dialed on. By default the listen address
+// of a port is used, or localhost if it listens on all interfaces.
+func WithStratumHost(host string) CheckerOpts {
+	return func(c *Checker) {
+		c.stratumHost = host
+	}
+}
+
+// WithoutPortChecks disables the stratum port checks.
+func WithoutPortChecks() CheckerOpts {
+	return func(c *Checker) {
+		c.checkPorts = false
+	}
+}
+
+// WithDialTimeout sets the timeout for connecting to a stratum port.
+func WithDialTimeout(d time.Duration) CheckerOpts {
+	return func(c *Checker) {
+		c.dialTimeout = d
+	}
+}
+
+// WithStateFile keeps the block heights in a JSON file between runs, so the stuck height
+// check also works if every run uses a new checker, e.g. when run as a Nagios plugin.
+func WithStateFile(path string) CheckerOpts {
+	return func(c *Checker) {
+		c.stateFile = path
+	}
+}
+
+// Checker runs the health checks. It remembers the block height of every pool between runs,
+// so long running checkers also detect block heights that stopped advancing.
+// One-shot checkers need a state file for that, see WithStateFile.
+type Checker struct {
+	client       *miningcore.Client
+	pools        []string
+	maxBlockAge  time.Duration
+	poolBlockAge map[string]time.Duration
+	noPayments   map[string]bool
+	stratumHost  string
+	checkPorts   bool
+	dialTimeout  time.Duration
+	stateFile    string
+	now          func() time.Time
+
+	mu      sync.Mutex
+	heights map[string]height
+	loaded  bool
+}
+
+type height struct {
+	Height  int64     `json:"height"`
+	Changed time.Time `json:"changed"`
+}
+
+// New creates a new checker.
+func New(c *miningcore.Client, opts ...CheckerOpts) *Checker {
+	ch := &Checker{
+		client:       c,
+		maxBlockAge:  time.Hour,
+		poolBlockAge: make(map[string]time.Duration),
+		noPayments:   make(map[string]bool),
+		checkPorts:   true,
+		dialTimeout:  5 * time.Second,
+		now:          time.Now,
+		heights:      make(map[string]height),
+	}
+	for _, opt := range opts {
+		opt(ch)
+	}
+	return ch
+}
+
+// Check runs all checks once.
+func (c *Checker) Check(ctx context.Context) *Report {
+	r := &Report{Time: c.now()}
+	if err := c.loadState(); err != nil {
+		r.add("", "state", Warning, "loading %s: %v", c.stateFile, err)
+	}
+	pools, _, err := c.client.GetPools(ctx)
+	if err != nil {
+		r.add("", "api", Critical, "api unreachable: %v", err)
+		return r
+	}
+	r.add("", "api", OK, "%d pools available", len(pools))
+
+	byID := make(map[string]*miningcore.PoolInfo, len(pools))
+	for _, p := range pools {
+		byID[p.ID] = p
+	}
+	ids := c.pools
+	if len(ids) == 0 {
+		for _, p := range pools {
+			ids = append(ids, p.ID)
+		}
+	}
+	for _, id := range ids {
+		pool, ok := byID[id]
+		if !ok {
+			r.add(id, "pool", Critical, "pool not found")
+			continue
+		}
+		c.checkPool(ctx, r, pool)
+	}
+	if err := c.saveState(); err != nil {
+		r.add("", "state", Warning, "saving %s: %v", c.stateFile, err)
+	}
+	return r
+}
+
+// loadState reads the block heights from the state file once.
+func (c *Checker) loadState() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stateFile == "" || c.loaded {
+		return nil
+	}
+	c.loaded = true
+	data, err := os.ReadFile(c.stateFile)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, &c.heights)
+}
+
+// saveState writes the block heights to the state file. The lock is held until the file is
+// replaced, so concurrent checks don't write the same temporary file.
+func (c *Checker) saveState() error {
+	if c.stateFile == "" {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	data, err := json.MarshalIndent(c.heights, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := c.stateFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.stateFile)
+}
+
+func (c *Checker) checkPool(ctx context.Context, r *Report, pool *miningcore.PoolInfo) {
+	now := r.Time
+	if s := pool.NetworkStats; s == nil {
+		r.add(pool.ID, "network", Unknown, "no network stats")
+	} else {
+		c.checkBlockTime(r, pool.ID, s.LastNetworkBlockTime, now)
+		c.checkHeight(r, pool.ID, s.BlockHeight, now)
+		if s.ConnectedPeers > 0 {
+			r.add(pool.ID, "peers", OK, "%d peers connected", s.ConnectedPeers)
+		} else {
+			r.add(pool.ID, "peers", Critical, "no peers connected")
+		}
+	}
+
+	enabled := pool.PaymentProcessing != nil && pool.PaymentProcessing.Enabled
+	switch {
+	case c.noPayments[pool.ID] && enabled:
+		r.add(pool.ID, "payments", Warning, "payment processing enabled but not expected")
+	case c.noPayments[pool.ID] || enabled:
+		r.add(pool.ID, "payments", OK, "payment processing as expected")
+	default:
+		r.add(pool.ID, "payments", Warning, "payment processing disabled")
+	}
+
+	if c.checkPorts {
+		c.checkStratumPorts(ctx, r, pool)
+	}
+}
+
+func (c *Checker) checkBlockTime(r *Report, id, last string, now time.Time) {
+	maxAge := c.maxBlockAge
+	if d, ok := c.poolBlockAge[id]; ok {
+		maxAge = d
+	}
+	t, err := miningcore.ParseTime(last)
+	if err != nil {
+		r.add(id, "blocktime", Unknown, "invalid last network block time %q", last)
+		return
+	}
+	age := now.Sub(t).Round(time.Second)
+	if age > maxAge {
+		r.add(id, "blocktime", Critical, "last network block %s ago, more than %s", age, maxAge)
+		return
+	}
+	r.add(id, "blocktime", OK, "last network block %s ago", age)
+}
+
+func (c *Checker) checkHeight(r *Report, id string, h int64, now time.Time) {
+	maxAge := c.maxBlockAge
+	if d, ok := c.poolBlockAge[id]; ok {
+		maxAge = d
+	}
+	c.mu.Lock()
+	prev, known := c.heights[id]
+	if !known || h != prev.Height {
+		c.heights[id] = height{Height: h, Changed: now}
+	}
+	c.mu.Unlock()
+
+	switch {
+	case !known:
+		r.add(id, "height", OK, "block height %d", h)
+	case h < prev.Height:
+		r.add(id, "height", Critical, "block height went back from %d to %d", prev.Height, h)
+	case h == prev.Height && now.Sub(prev.Changed) > maxAge:
+		r.add(id, "height", Critical, "block height stuck at %d since %s", h, prev.Changed.Format(time.RFC3339))
+	default:
+		r.add(id, "height", OK, "block height %d", h)
+	}
+}
+
+func (c *Checker) checkStratumPorts(ctx context.Context, r *Report, pool *miningcore.PoolInfo) {
+	var d net.Dialer
+	d.Timeout = c.dialTimeout
+	for _, p := range pool.SortedPorts() {
+		port := strconv.Itoa(p.Number)
+		addr := net.JoinHostPort(c.portHost(p.PoolEndpoint), port)
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			r.add(pool.ID, "port "+port, Critical, "%s unreachable: %v", addr, err)
+			continue
+		}
+		conn.Close()
+		r.add(pool.ID, "port "+port, OK, "%s accepts connections", addr)
+	}
+}
+
+func (c *Checker) portHost(e miningcore.PoolEndpoint) string {
+	if c.stratumHost != "" {
+		return c.stratumHost
+	}
+	return e.DialHost()
+}
+
+// Handler returns an http.Handler for liveness and readiness probes. It runs the checks on
+// every request and responds with the JSON report and status 200, or 503 if the status of
+// the report is at least as severe as failOn.
+func Handler(c *Checker, failOn Status) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report := c.Check(r.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if report.Status.severity() >= failOn.severity() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(report)
+	})
+}
@@ -0,0 +1,161 @@
+package health
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChecker(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer ln.Close()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	open := strconv.Itoa(ln.Addr().(*net.TCPAddr).Port)
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	closedPort := strconv.Itoa(closed.Addr().(*net.TCPAddr).Port)
+	closed.Close()
+
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	var mu sync.Mutex
+	pools := []*miningcore.PoolInfo{
+		{
+			ID:                "eth",
+			Ports:             map[string]miningcore.PoolEndpoint{open: {ListenAddress: "0.0.0.0"}, "stratum": {}},
+			PaymentProcessing: &miningcore.APIPoolPaymentProcessingConfig{Enabled: true},
+			NetworkStats: &miningcore.BlockchainStats{
+				BlockHeight:          100,
+				ConnectedPeers:       10,
+				LastNetworkBlockTime: now.Add(-time.Minute).Format(time.RFC3339),
+			},
+		},
+		{
+			ID:                "btc",
+			Ports:             map[string]miningcore.PoolEndpoint{closedPort: {ListenAddress: "127.0.0.1"}},
+			PaymentProcessing: &miningcore.APIPoolPaymentProcessingConfig{},
+			NetworkStats: &miningcore.BlockchainStats{
+				BlockHeight:          50,
+				LastNetworkBlockTime: now.Add(-2 * time.Hour).Format(time.RFC3339),
+			},
+		},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]any{"pools": pools})
+	}))
+	defer ts.Close()
+
+	c := New(miningcore.New(ts.URL), WithStratumHost("127.0.0.1"), WithPoolMaxBlockAge("btc", 3*time.Hour))
+	c.now = func() time.Time { return now }
+	r := c.Check(context.Background())
+	statuses := func(r *Report) map[string]Status {
+		res := make(map[string]Status)
+		for _, v := range r.Results {
+			res[v.Pool+"/"+v.Name] = v.Status
+		}
+		return res
+	}
+	assert.Equal(t, map[string]Status{
+		"/api":                   OK,
+		"eth/blocktime":          OK,
+		"eth/height":             OK,
+		"eth/peers":              OK,
+		"eth/payments":           OK,
+		"eth/port " + open:       OK,
+		"btc/blocktime":          OK,
+		"btc/height":             OK,
+		"btc/peers":              Critical,
+		"btc/payments":           Warning,
+		"btc/port " + closedPort: Critical,
+	}, statuses(r))
+	assert.Equal(t, Critical, r.Status)
+	assert.Equal(t, 2, r.ExitCode())
+
+	var buf bytes.Buffer
+	assert.NoError(t, r.WriteText(&buf))
+	assert.True(t, strings.HasPrefix(buf.String(), "MININGCORE CRITICAL - 3 of 11 checks failed\n"))
+
+	// eth stops advancing while its last block time stays recent
+	mu.Lock()
+	pools = pools[:1]
+	mu.Unlock()
+	now = now.Add(2 * time.Hour)
+	r = c.Check(context.Background())
+	assert.Equal(t, Critical, statuses(r)["eth/blocktime"])
+	assert.Equal(t, Critical, statuses(r)["eth/height"])
+
+	c = New(miningcore.New(ts.URL), WithoutPortChecks(), WithMaxBlockAge(24*time.Hour))
+	c.now = func() time.Time { return now }
+	rec := httptest.NewRecorder()
+	Handler(c, Critical).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	Handler(New(miningcore.New("http://127.0.0.1:"+closedPort)), Critical).
+		ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"status":"CRITICAL"`)
+}
+
+func TestStateFile(t *testing.T) {
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"pools": []*miningcore.PoolInfo{{
+			ID:                "eth",
+			PaymentProcessing: &miningcore.APIPoolPaymentProcessingConfig{Enabled: true},
+			NetworkStats: &miningcore.BlockchainStats{
+				BlockHeight:          100,
+				ConnectedPeers:       10,
+				LastNetworkBlockTime: now.Format(time.RFC3339),
+			},
+		}}})
+	}))
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "state.json")
+	check := func(at time.Time) *Report {
+		c := New(miningcore.New(ts.URL), WithoutPortChecks(), WithStateFile(path))
+		c.now = func() time.Time { return at }
+		return c.Check(context.Background())
+	}
+	assert.Equal(t, OK, check(now).Status)
+	r := check(now.Add(2 * time.Hour))
+	assert.Equal(t, Critical, r.Status)
+	assert.Contains(t, r.Results[len(r.Results)-3].Message, "block height stuck at 100")
+
+	// concurrent checks don't clobber each other's state file writes
+	c := New(miningcore.New(ts.URL), WithoutPortChecks(), WithStateFile(path))
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, res := range c.Check(context.Background()).Results {
+				assert.NotEqual(t, "state", res.Name, res.Message)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,49 @@
+// Package blocks follows pending blocks through the paged block history of a pool.
+package blocks
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// PageSize is the default page size of the api.
+const PageSize = 15
+
+// Follow returns the first page of blocks and as many further pages as needed to find all
+// pending blocks, newest first. pending maps the keys of the pending blocks to their creation
+// time, it is emptied of the blocks that were found.
+func Follow(ctx context.Context, fetch miningcore.PageFunc[*miningcore.Block], pending map[string]time.Time, key func(*miningcore.Block) string) ([]*miningcore.Block, error) {
+	var blocks []*miningcore.Block
+	for page := 0; ; page++ {
+		items, err := fetch(ctx, map[string]string{"page": strconv.Itoa(page), "pageSize": strconv.Itoa(PageSize)})
+		last := errors.Is(err, miningcore.ErrLastPage)
+		if err != nil && !last {
+			return nil, err
+		}
+		blocks = append(blocks, items...)
+		for _, b := range items {
+			delete(pending, key(b))
+		}
+		if last || len(items) < PageSize || !olderPending(pending, items[len(items)-1]) {
+			return blocks, nil
+		}
+	}
+}
+
+// olderPending reports whether one of the pending blocks was created before b and is still to come.
+func olderPending(pending map[string]time.Time, b *miningcore.Block) bool {
+	created, err := miningcore.ParseTime(b.Created)
+	if err != nil {
+		return false
+	}
+	for _, t := range pending {
+		if !t.After(created) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package blocks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFollow(t *testing.T) {
+	start := time.Date(2022, 7, 2, 0, 0, 0, 0, time.UTC)
+	// 40 blocks, newest first, one per minute
+	var all []*miningcore.Block
+	for i := 40; i > 0; i-- {
+		all = append(all, &miningcore.Block{BlockHeight: int64(i), Created: start.Add(time.Duration(i) * time.Minute).Format(time.RFC3339)})
+	}
+	var pages int
+	fetch := func(_ context.Context, params map[string]string) ([]*miningcore.Block, error) {
+		pages++
+		from := (pages - 1) * PageSize
+		if from+PageSize >= len(all) {
+			return all[from:], miningcore.ErrLastPage
+		}
+		return all[from : from+PageSize], nil
+	}
+	key := func(b *miningcore.Block) string { return all[40-b.BlockHeight].Created }
+
+	res, err := Follow(context.Background(), fetch, nil, key)
+	assert.NoError(t, err)
+	assert.Len(t, res, PageSize)
+	assert.Equal(t, 1, pages)
+
+	// block 20 is on the second page
+	pages = 0
+	pending := map[string]time.Time{all[20].Created: start.Add(20 * time.Minute)}
+	res, err = Follow(context.Background(), fetch, pending, key)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2*PageSize)
+	assert.Empty(t, pending)
+
+	// a pending block that is gone stops the paging once older blocks are reached
+	pages = 0
+	pending = map[string]time.Time{"gone": start.Add(30 * time.Minute)}
+	res, err = Follow(context.Background(), fetch, pending, key)
+	assert.NoError(t, err)
+	assert.Len(t, res, PageSize)
+	assert.Equal(t, 1, pages)
+}
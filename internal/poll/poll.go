@@ -0,0 +1,36 @@
+// Package poll runs the poll loops of the watchers, feeds and trackers of this module.
+package poll
+
+import (
+	"context"
+	"time"
+)
+
+// Run calls fn right away and then on every interval until the context is canceled.
+// Errors of fn are passed to onError if it is set, they don't stop the loop.
+func Run(ctx context.Context, interval time.Duration, fn func(context.Context) error, onError func(error)) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		if err := fn(ctx); err != nil && onError != nil {
+			onError(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Each runs poll like Run and calls fn for every item it returns. Items returned along with
+// an error are still passed to fn.
+func Each[T any](ctx context.Context, interval time.Duration, poll func(context.Context) ([]T, error), fn func(T), onError func(error)) error {
+	return Run(ctx, interval, func(ctx context.Context) error {
+		items, err := poll(ctx)
+		for _, item := range items {
+			fn(item)
+		}
+		return err
+	}, onError)
+}
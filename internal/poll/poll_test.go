@@ -0,0 +1,29 @@
+package poll
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEach(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var polls int
+	var items []int
+	var errs []error
+	err := Each(ctx, time.Millisecond, func(context.Context) ([]int, error) {
+		polls++
+		if polls == 3 {
+			cancel()
+		}
+		return []int{polls}, errors.New("failed")
+	}, func(i int) { items = append(items, i) }, func(err error) { errs = append(errs, err) })
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int{1, 2, 3}, items)
+	assert.Len(t, errs, 3)
+}
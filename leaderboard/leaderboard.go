@@ -0,0 +1,349 @@
+// Package leaderboard ranks the miners of a pool by hashrate or shares per second, currently
+// or averaged over time windows, and tracks how their ranks change between refreshes.
+package leaderboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/internal/poll"
+)
+
+// Metric is the value miners are ranked by.
+type Metric string
+
+const (
+	ByHashrate        Metric = "hashrate"
+	BySharesPerSecond Metric = "sharesPerSecond"
+)
+
+// Current is the window of the current stats as reported by GetMiners.
+const Current time.Duration = 0
+
+// MaxWindow is the longest window, miningcore keeps 30 daily performance samples.
+const MaxWindow = 30 * 24 * time.Hour
+
+// Entry is a ranked miner. Delta is the number of ranks the miner moved up since the previous
+// refresh, negative if it moved down. New is set if the miner wasn't ranked before.
+type Entry struct {
+	Rank            int                 `json:"rank"`
+	Miner           string              `json:"miner"`
+	Hashrate        miningcore.Hashrate `json:"hashrate"`
+	SharesPerSecond float64             `json:"sharesPerSecond"`
+	Delta           int                 `json:"delta"`
+	New             bool                `json:"new"`
+}
+
+// Board is the ranking of a pool for a metric and window.
+type Board struct {
+	Pool    string        `json:"pool"`
+	Metric  Metric        `json:"metric"`
+	Window  time.Duration `json:"window"`
+	Updated time.Time     `json:"updated"`
+	Entries []Entry       `json:"entries"`
+}
+
+// LeaderboardOpts are options for the leaderboard.
+type LeaderboardOpts func(*Leaderboard)
+
+// WithSize sets the number of ranked miners.
+func WithSize(n int) LeaderboardOpts {
+	return func(l *Leaderboard) {
+		l.size = n
+	}
+}
+
+// WithWindows adds time windows over which the average hashrate and shares per second are ranked.
+// Windows of up to a day use hourly, longer ones daily performance samples. Windows can be
+// at most MaxWindow long.
+func WithWindows(windows ...time.Duration) LeaderboardOpts {
+	return func(l *Leaderboard) {
+		l.windows = append(l.windows, windows...)
+	}
+}
+
+// WithInterval sets the refresh interval of Run.
+func WithInterval(d time.Duration) LeaderboardOpts {
+	return func(l *Leaderboard) {
+		l.interval = d
+	}
+}
+
+// WithErrorHandler sets a function that is called with errors of failed refreshes in Run.
+func WithErrorHandler(fn func(error)) LeaderboardOpts {
+	return func(l *Leaderboard) {
+		l.onError = fn
+	}
+}
+
+// Leaderboard ranks the miners of a pool. The candidates are the miners listed by GetMiners
+// and the top miners of the pool, window rankings are computed for the top candidates only.
+type Leaderboard struct {
+	client   *miningcore.Client
+	pool     string
+	size     int
+	windows  []time.Duration
+	interval time.Duration
+	onError  func(error)
+	now      func() time.Time
+
+	mu     sync.RWMutex
+	boards map[boardKey]*Board
+}
+
+type boardKey struct {
+	metric Metric
+	window time.Duration
+}
+
+// New creates a new leaderboard for a pool. It returns an error if a window is negative or
+// longer than MaxWindow.
+func New(c *miningcore.Client, pool string, opts ...LeaderboardOpts) (*Leaderboard, error) {
+	l := &Leaderboard{
+		client:   c,
+		pool:     pool,
+		size:     100,
+		windows:  []time.Duration{Current},
+		interval: 5 * time.Minute,
+		now:      time.Now,
+		boards:   make(map[boardKey]*Board),
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	for _, w := range l.windows {
+		if w < 0 || w > MaxWindow {
+			return nil, fmt.Errorf("invalid window %s, windows can be at most %s", w, MaxWindow)
+		}
+	}
+	return l, nil
+}
+
+// Run refreshes the leaderboard until the context is canceled.
+func (l *Leaderboard) Run(ctx context.Context) error {
+	return poll.Run(ctx, l.interval, l.Refresh, l.onError)
+}
+
+// Refresh fetches the current stats and recomputes all rankings. Miners whose performance
+// samples fail to load are left out of the window rankings, the first such error is returned
+// once all boards are updated.
+func (l *Leaderboard) Refresh(ctx context.Context) error {
+	candidates, err := l.candidates(ctx)
+	if err != nil {
+		return err
+	}
+	now := l.now()
+	var firstErr error
+	for _, w := range l.windows {
+		stats := candidates
+		if w != Current {
+			stats, err = l.windowStats(ctx, candidates, w, now)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+		}
+		for _, m := range []Metric{ByHashrate, BySharesPerSecond} {
+			l.update(m, w, rank(stats, m, l.size), now)
+		}
+	}
+	return firstErr
+}
+
+// Board returns the ranking for a metric and window or nil if it wasn't computed yet.
+func (l *Leaderboard) Board(metric Metric, window time.Duration) *Board {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return l.boards[boardKey{metric, window}]
+}
+
+// ServeHTTP serves a board as json. The query parameters metric (hashrate or sharesPerSecond),
+// window (a duration like 24h, empty for the current stats) and limit select the board and
+// the number of entries.
+func (l *Leaderboard) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	metric := Metric(q.Get("metric"))
+	if metric == "" {
+		metric = ByHashrate
+	}
+	if metric != ByHashrate && metric != BySharesPerSecond {
+		http.Error(w, fmt.Sprintf("invalid metric %q", metric), http.StatusBadRequest)
+		return
+	}
+	window := Current
+	if s := q.Get("window"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid window %q", s), http.StatusBadRequest)
+			return
+		}
+		window = d
+	}
+	b := l.Board(metric, window)
+	if b == nil {
+		http.Error(w, "leaderboard not available", http.StatusNotFound)
+		return
+	}
+	if s := q.Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			http.Error(w, fmt.Sprintf("invalid limit %q", s), http.StatusBadRequest)
+			return
+		}
+		if n < len(b.Entries) {
+			limited := *b
+			limited.Entries = b.Entries[:n]
+			b = &limited
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(b)
+}
+
+func (l *Leaderboard) update(m Metric, window time.Duration, entries []Entry, now time.Time) {
+	key := boardKey{m, window}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	prev := make(map[string]int)
+	if b := l.boards[key]; b != nil {
+		for _, e := range b.Entries {
+			prev[e.Miner] = e.Rank
+		}
+	}
+	for i := range entries {
+		if r, ok := prev[entries[i].Miner]; ok {
+			entries[i].Delta = r - entries[i].Rank
+		} else {
+			entries[i].New = true
+		}
+	}
+	l.boards[key] = &Board{Pool: l.pool, Metric: m, Window: window, Updated: now, Entries: entries}
+}
+
+// candidates returns the current stats of all miners of the pool.
+func (l *Leaderboard) candidates(ctx context.Context) ([]*miningcore.MinerPerformanceStats, error) {
+	pool, _, err := l.client.GetPool(ctx, l.pool)
+	if err != nil {
+		return nil, err
+	}
+	byMiner := make(map[string]*miningcore.MinerPerformanceStats)
+	var res []*miningcore.MinerPerformanceStats
+	add := func(m *miningcore.MinerPerformanceStats) {
+		if _, ok := byMiner[m.Miner]; !ok {
+			byMiner[m.Miner] = m
+			res = append(res, m)
+		}
+	}
+	err = miningcore.Paginate(ctx, 0, l.client.MinersPages(l.pool), func(m *miningcore.MinerPerformanceStats) (bool, error) {
+		add(m)
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range pool.TopMiners {
+		add(m)
+	}
+	return res, nil
+}
+
+// windowStats averages the performance samples of the top candidates over the window.
+// Missing samples count as zero, so miners that were offline for a part of the window rank lower.
+// Miners whose samples fail to load are skipped, the first error is returned with the stats of the others.
+func (l *Leaderboard) windowStats(ctx context.Context, candidates []*miningcore.MinerPerformanceStats, window time.Duration, now time.Time) ([]*miningcore.MinerPerformanceStats, error) {
+	// the api samples hourly for a day and daily for a month
+	mode, interval := "Day", time.Hour
+	if window > 24*time.Hour {
+		mode, interval = "Month", 24*time.Hour
+	}
+	expected := int(window / interval)
+	if expected < 1 {
+		expected = 1
+	}
+	// miners outside of the current top are unlikely to make it into the window ranking
+	top := rank(candidates, ByHashrate, 2*l.size)
+	res := make([]*miningcore.MinerPerformanceStats, 0, len(top))
+	var firstErr error
+	for _, e := range top {
+		s, err := l.minerWindowStats(ctx, e.Miner, mode, window, now, expected)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("miner %s: %w", e.Miner, err)
+			}
+			continue
+		}
+		res = append(res, s)
+	}
+	return res, firstErr
+}
+
+// minerWindowStats averages the performance samples of a miner over the window, dividing by
+// at least the expected number of samples.
+func (l *Leaderboard) minerWindowStats(ctx context.Context, miner, mode string, window time.Duration, now time.Time, expected int) (*miningcore.MinerPerformanceStats, error) {
+	samples, _, err := l.client.GetMinerPerformance(ctx, l.pool, miner, map[string]string{"mode": mode})
+	if err != nil {
+		return nil, err
+	}
+	s := &miningcore.MinerPerformanceStats{Miner: miner}
+	var n int
+	for _, sample := range samples {
+		created, err := miningcore.ParseTime(sample.Created)
+		if err != nil {
+			return nil, err
+		}
+		if created.Before(now.Add(-window)) || created.After(now) {
+			continue
+		}
+		n++
+		for _, w := range sample.Workers {
+			s.Hashrate += w.Hashrate
+			s.SharesPerSecond += w.SharesPerSecond
+		}
+	}
+	if n < expected {
+		n = expected
+	}
+	s.Hashrate /= miningcore.Hashrate(n)
+	s.SharesPerSecond /= float64(n)
+	return s, nil
+}
+
+// rank sorts the miners by the metric and returns the top n with a value above zero.
+func rank(stats []*miningcore.MinerPerformanceStats, m Metric, n int) []Entry {
+	value := func(s *miningcore.MinerPerformanceStats) float64 {
+		if m == BySharesPerSecond {
+			return s.SharesPerSecond
+		}
+		return float64(s.Hashrate)
+	}
+	sorted := make([]*miningcore.MinerPerformanceStats, 0, len(stats))
+	for _, s := range stats {
+		if value(s) > 0 {
+			sorted = append(sorted, s)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := value(sorted[i]), value(sorted[j])
+		if a != b {
+			return a > b
+		}
+		return sorted[i].Miner < sorted[j].Miner
+	})
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	entries := make([]Entry, len(sorted))
+	for i, s := range sorted {
+		entries[i] = Entry{Rank: i + 1, Miner: s.Miner, Hashrate: s.Hashrate, SharesPerSecond: s.SharesPerSecond}
+	}
+	return entries
+}
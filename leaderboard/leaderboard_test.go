@@ -0,0 +1,148 @@
+package leaderboard
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+type poolServer struct {
+	mu     sync.Mutex
+	miners []*miningcore.MinerPerformanceStats
+	top    []*miningcore.MinerPerformanceStats
+	perf   map[string][]*miningcore.WorkerStats
+	broken map[string]bool
+}
+
+func (s *poolServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(parts) == 6 && parts[5] == "performance":
+		if s.broken[parts[4]] {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(s.perf[parts[4]])
+	case len(parts) == 4 && parts[3] == "miners":
+		if r.URL.Query().Get("page") != "0" {
+			json.NewEncoder(w).Encode([]*miningcore.MinerPerformanceStats{})
+			return
+		}
+		json.NewEncoder(w).Encode(s.miners)
+	default:
+		json.NewEncoder(w).Encode(map[string]any{"pool": miningcore.PoolInfo{ID: "eth", TopMiners: s.top}})
+	}
+}
+
+func sample(created time.Time, hashrate miningcore.Hashrate) *miningcore.WorkerStats {
+	return &miningcore.WorkerStats{
+		Created: created.Format(time.RFC3339),
+		Workers: map[string]*miningcore.WorkerPerformanceStats{
+			"rig1": {Hashrate: hashrate / 2, SharesPerSecond: 1},
+			"rig2": {Hashrate: hashrate / 2, SharesPerSecond: 1},
+		},
+	}
+}
+
+func TestLeaderboard(t *testing.T) {
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	srv := &poolServer{
+		miners: []*miningcore.MinerPerformanceStats{
+			{Miner: "a", Hashrate: 300, SharesPerSecond: 1},
+			{Miner: "b", Hashrate: 200, SharesPerSecond: 3},
+			{Miner: "idle"},
+		},
+		top: []*miningcore.MinerPerformanceStats{{Miner: "c", Hashrate: 100, SharesPerSecond: 2}},
+		perf: map[string][]*miningcore.WorkerStats{
+			"a": {sample(now.Add(-2*time.Hour), 100), sample(now.Add(-time.Hour), 100)},
+			"b": {sample(now.Add(-48*time.Hour), 10000), sample(now.Add(-time.Hour), 400)},
+			"c": {sample(now.Add(-time.Hour), 50)},
+		},
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	l, err := New(miningcore.New(ts.URL), "eth", WithSize(3), WithWindows(24*time.Hour))
+	assert.NoError(t, err)
+	l.now = func() time.Time { return now }
+	assert.NoError(t, l.Refresh(context.Background()))
+
+	miners := func(b *Board) []string {
+		var res []string
+		for _, e := range b.Entries {
+			res = append(res, e.Miner)
+		}
+		return res
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, miners(l.Board(ByHashrate, Current)))
+	assert.Equal(t, []string{"b", "c", "a"}, miners(l.Board(BySharesPerSecond, Current)))
+	day := l.Board(ByHashrate, 24*time.Hour)
+	assert.Equal(t, []string{"b", "a", "c"}, miners(day))
+	// a single hourly sample in a day of 24 expected ones
+	assert.InDelta(t, 400.0/24, float64(day.Entries[0].Hashrate), 1e-9)
+	assert.True(t, day.Entries[0].New)
+
+	srv.mu.Lock()
+	srv.miners[1].Hashrate = 500
+	srv.mu.Unlock()
+	assert.NoError(t, l.Refresh(context.Background()))
+	b := l.Board(ByHashrate, Current)
+	assert.Equal(t, []string{"b", "a", "c"}, miners(b))
+	assert.Equal(t, 1, b.Entries[0].Delta)
+	assert.Equal(t, -1, b.Entries[1].Delta)
+	assert.Equal(t, 0, b.Entries[2].Delta)
+	assert.False(t, b.Entries[0].New)
+
+	rec := httptest.NewRecorder()
+	l.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?window=24h&limit=1", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var got Board
+	assert.NoError(t, json.NewDecoder(rec.Body).Decode(&got))
+	assert.Equal(t, []string{"b"}, miners(&got))
+	assert.Equal(t, 24*time.Hour, got.Window)
+
+	rec = httptest.NewRecorder()
+	l.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?window=1h", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	rec = httptest.NewRecorder()
+	l.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?metric=luck", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestLeaderboardWindowErrors(t *testing.T) {
+	_, err := New(nil, "eth", WithWindows(31*24*time.Hour))
+	assert.Error(t, err)
+
+	now := time.Date(2022, 7, 1, 12, 0, 0, 0, time.UTC)
+	srv := &poolServer{
+		miners: []*miningcore.MinerPerformanceStats{
+			{Miner: "a", Hashrate: 300},
+			{Miner: "b", Hashrate: 200},
+		},
+		perf:   map[string][]*miningcore.WorkerStats{"a": {sample(now.Add(-time.Hour), 100)}},
+		broken: map[string]bool{"b": true},
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	l, err := New(miningcore.New(ts.URL), "eth", WithWindows(MaxWindow))
+	assert.NoError(t, err)
+	l.now = func() time.Time { return now }
+
+	// the miner whose samples fail is left out, the other boards are still updated
+	assert.Error(t, l.Refresh(context.Background()))
+	assert.Len(t, l.Board(ByHashrate, Current).Entries, 2)
+	month := l.Board(ByHashrate, MaxWindow)
+	assert.Len(t, month.Entries, 1)
+	assert.Equal(t, "a", month.Entries[0].Miner)
+}
@@ -0,0 +1,224 @@
+// Package metrics turns pool, network and worker stats into InfluxDB line protocol or
+// Graphite plaintext and ships them to a TCP, UDP or HTTP sink.
+package metrics
+
+import (
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Point is a single measurement with its tags and fields.
+type Point struct {
+	Measurement string
+	Tags        map[string]string
+	Fields      map[string]float64
+	Time        time.Time
+}
+
+// Encoder writes points in a wire format.
+type Encoder interface {
+	Encode(w io.Writer, points []Point) error
+}
+
+// LineProtocol encodes points in the InfluxDB line protocol with nanosecond timestamps.
+type LineProtocol struct{}
+
+// Encode implements Encoder.
+func (LineProtocol) Encode(w io.Writer, points []Point) error {
+	var b strings.Builder
+	for _, p := range points {
+		if len(p.Fields) == 0 {
+			continue
+		}
+		b.WriteString(lpEscaper.Replace(p.Measurement))
+		for _, k := range sortedKeys(p.Tags) {
+			if p.Tags[k] == "" {
+				continue
+			}
+			b.WriteByte(',')
+			b.WriteString(lpTagEscaper.Replace(k))
+			b.WriteByte('=')
+			b.WriteString(lpTagEscaper.Replace(p.Tags[k]))
+		}
+		b.WriteByte(' ')
+		for i, k := range sortedKeys(p.Fields) {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			b.WriteString(lpTagEscaper.Replace(k))
+			b.WriteByte('=')
+			b.WriteString(strconv.FormatFloat(p.Fields[k], 'f', -1, 64))
+		}
+		b.WriteByte(' ')
+		b.WriteString(strconv.FormatInt(p.Time.UnixNano(), 10))
+		b.WriteByte('\n')
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+var (
+	lpEscaper    = strings.NewReplacer(",", `\,`, " ", `\ `)
+	lpTagEscaper = strings.NewReplacer(",", `\,`, " ", `\ `, "=", `\=`)
+)
+
+// Graphite encodes points in the Graphite plaintext protocol using tagged series,
+// one line per field: "prefix.measurement.field;tag=value value timestamp".
+type Graphite struct {
+	Prefix string
+}
+
+// Encode implements Encoder.
+func (g Graphite) Encode(w io.Writer, points []Point) error {
+	var b strings.Builder
+	for _, p := range points {
+		for _, field := range sortedKeys(p.Fields) {
+			if g.Prefix != "" {
+				b.WriteString(graphiteName(g.Prefix))
+				b.WriteByte('.')
+			}
+			b.WriteString(graphiteName(p.Measurement))
+			b.WriteByte('.')
+			b.WriteString(graphiteName(field))
+			for _, k := range sortedKeys(p.Tags) {
+				if p.Tags[k] == "" {
+					continue
+				}
+				b.WriteByte(';')
+				b.WriteString(graphiteName(k))
+				b.WriteByte('=')
+				b.WriteString(graphiteTagValue(p.Tags[k]))
+			}
+			b.WriteByte(' ')
+			b.WriteString(strconv.FormatFloat(p.Fields[field], 'f', -1, 64))
+			b.WriteByte(' ')
+			b.WriteString(strconv.FormatInt(p.Time.Unix(), 10))
+			b.WriteByte('\n')
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func graphiteName(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-' || r == '.' {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+func graphiteTagValue(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == ';' || r == '~' || r == ' ' || r == '\n' {
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// PoolPoints returns the "pool" and "network" points of a pool.
+func PoolPoints(pool *miningcore.PoolInfo, t time.Time) []Point {
+	var points []Point
+	if s := pool.PoolStats; s != nil {
+		points = append(points, Point{
+			Measurement: "pool",
+			Tags:        poolTags(pool),
+			Fields: map[string]float64{
+				"connectedMiners": float64(s.ConnectedMiners),
+				"hashrate":        float64(s.PoolHashrate),
+				"sharesPerSecond": float64(s.SharesPerSecond),
+			},
+			Time: t,
+		})
+	}
+	if s := pool.NetworkStats; s != nil {
+		tags := poolTags(pool)
+		tags["networkType"] = s.NetworkType
+		points = append(points, Point{
+			Measurement: "network",
+			Tags:        tags,
+			Fields: map[string]float64{
+				"hashrate":       float64(s.NetworkHashrate),
+				"difficulty":     float64(s.NetworkDifficulty),
+				"blockHeight":    float64(s.BlockHeight),
+				"connectedPeers": float64(s.ConnectedPeers),
+			},
+			Time: t,
+		})
+	}
+	return points
+}
+
+// PoolPerformancePoints returns one "pool_performance" point per performance sample.
+func PoolPerformancePoints(pool *miningcore.PoolInfo, samples []*miningcore.PoolPerformance) ([]Point, error) {
+	points := make([]Point, 0, len(samples))
+	for _, s := range samples {
+		t, err := miningcore.ParseTime(s.Created)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, Point{
+			Measurement: "pool_performance",
+			Tags:        poolTags(pool),
+			Fields: map[string]float64{
+				"poolHashrate":         float64(s.PoolHashrate),
+				"connectedMiners":      float64(s.ConnectedMiners),
+				"validSharesPerSecond": float64(s.ValidSharesPerSecond),
+				"networkHashrate":      float64(s.NetworkHashrate),
+				"networkDifficulty":    float64(s.NetworkDifficulty),
+			},
+			Time: t,
+		})
+	}
+	return points, nil
+}
+
+// WorkerPoints returns one "worker" point per worker of a miner.
+func WorkerPoints(pool *miningcore.PoolInfo, addr string, stats *miningcore.WorkerStats, t time.Time) []Point {
+	if stats == nil {
+		return nil
+	}
+	points := make([]Point, 0, len(stats.Workers))
+	for _, name := range sortedKeys(stats.Workers) {
+		w := stats.Workers[name]
+		tags := poolTags(pool)
+		tags["miner"] = addr
+		tags["worker"] = name
+		points = append(points, Point{
+			Measurement: "worker",
+			Tags:        tags,
+			Fields: map[string]float64{
+				"hashrate":         float64(w.Hashrate),
+				"reportedHashrate": float64(w.ReportedHashrate),
+				"sharesPerSecond":  w.SharesPerSecond,
+			},
+			Time: t,
+		})
+	}
+	return points
+}
+
+func poolTags(pool *miningcore.PoolInfo) map[string]string {
+	tags := map[string]string{"pool": pool.ID}
+	if pool.Coin != nil {
+		tags["symbol"] = pool.Coin.Symbol
+		tags["algorithm"] = pool.Coin.Algorithm
+	}
+	return tags
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
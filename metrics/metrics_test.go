@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+var testPool = &miningcore.PoolInfo{
+	ID:        "eth",
+	Coin:      &miningcore.APICoinConfig{Symbol: "ETH", Algorithm: "Ethhash"},
+	PoolStats: &miningcore.PoolStats{ConnectedMiners: 5, PoolHashrate: 2e10, SharesPerSecond: 10},
+}
+
+func TestLineProtocol(t *testing.T) {
+	points := PoolPoints(testPool, time.Unix(1657000000, 0))
+	points = append(points, WorkerPoints(testPool, "0xabc", &miningcore.WorkerStats{
+		Workers: map[string]*miningcore.WorkerPerformanceStats{"rig 1": {Hashrate: 100, ReportedHashrate: 110, SharesPerSecond: 0.5}},
+	}, time.Unix(1657000000, 0))...)
+
+	var buf bytes.Buffer
+	assert.NoError(t, LineProtocol{}.Encode(&buf, points))
+	assert.Equal(t,
+		"pool,algorithm=Ethhash,pool=eth,symbol=ETH connectedMiners=5,hashrate=20000000000,sharesPerSecond=10 1657000000000000000\n"+
+			`worker,algorithm=Ethhash,miner=0xabc,pool=eth,symbol=ETH,worker=rig\ 1 hashrate=100,reportedHashrate=110,sharesPerSecond=0.5 1657000000000000000`+"\n",
+		buf.String())
+}
+
+func TestGraphite(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, Graphite{Prefix: "mc"}.Encode(&buf, PoolPoints(testPool, time.Unix(1657000000, 0))))
+	assert.Equal(t,
+		"mc.pool.connectedMiners;algorithm=Ethhash;pool=eth;symbol=ETH 5 1657000000\n"+
+			"mc.pool.hashrate;algorithm=Ethhash;pool=eth;symbol=ETH 20000000000 1657000000\n"+
+			"mc.pool.sharesPerSecond;algorithm=Ethhash;pool=eth;symbol=ETH 10 1657000000\n",
+		buf.String())
+}
+
+func TestShipper(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"pool": testPool})
+	}))
+	defer api.Close()
+
+	received := make(chan string, 1)
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		received <- string(data)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer sink.Close()
+
+	s, err := NewSink(sink.URL)
+	assert.NoError(t, err)
+	shipper := NewShipper(miningcore.New(api.URL), []string{"eth"}, LineProtocol{}, s)
+	shipper.now = func() time.Time { return time.Unix(1, 0) }
+	assert.NoError(t, shipper.Ship(context.Background()))
+	assert.Equal(t, "pool,algorithm=Ethhash,pool=eth,symbol=ETH connectedMiners=5,hashrate=20000000000,sharesPerSecond=10 1000000000\n", <-received)
+}
+
+func TestTCPSink(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer l.Close()
+	received := make(chan string, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		data, _ := io.ReadAll(conn)
+		received <- string(data)
+	}()
+
+	s, err := NewSink("tcp://" + l.Addr().String())
+	assert.NoError(t, err)
+	assert.NoError(t, s.Send(context.Background(), []byte("a 1 1\n")))
+	assert.Equal(t, "a 1 1\n", <-received)
+
+	_, err = NewSink("ftp://localhost")
+	assert.Error(t, err)
+}
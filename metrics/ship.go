@@ -0,0 +1,210 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/internal/poll"
+)
+
+// Sink receives encoded metrics.
+type Sink interface {
+	Send(ctx context.Context, data []byte) error
+}
+
+// NewSink creates a sink from a URL. Supported schemes are tcp://host:port, udp://host:port
+// and http(s)://, which posts the metrics to the given URL, e.g. the InfluxDB write endpoint.
+func NewSink(rawURL string) (Sink, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case "tcp", "udp":
+		return &netSink{network: u.Scheme, addr: u.Host}, nil
+	case "http", "https":
+		return &httpSink{url: u.String(), http: &http.Client{Timeout: 10 * time.Second}}, nil
+	}
+	return nil, fmt.Errorf("unsupported sink scheme %q", u.Scheme)
+}
+
+type netSink struct {
+	network string
+	addr    string
+}
+
+func (s *netSink) Send(ctx context.Context, data []byte) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, s.network, s.addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetWriteDeadline(deadline); err != nil {
+			return err
+		}
+	}
+	_, err = conn.Write(data)
+	return err
+}
+
+type httpSink struct {
+	url  string
+	http *http.Client
+}
+
+func (s *httpSink) Send(ctx context.Context, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("sink returned %s", resp.Status)
+	}
+	return nil
+}
+
+// Miner is a miner address whose workers are shipped.
+type Miner struct {
+	PoolID  string
+	Address string
+}
+
+// ShipperOpts are options for the shipper.
+type ShipperOpts func(*Shipper)
+
+// WithInterval sets the collect interval.
+func WithInterval(d time.Duration) ShipperOpts {
+	return func(s *Shipper) {
+		s.interval = d
+	}
+}
+
+// WithMiners adds miners whose worker stats are shipped along with the pool stats.
+func WithMiners(miners ...Miner) ShipperOpts {
+	return func(s *Shipper) {
+		s.miners = append(s.miners, miners...)
+	}
+}
+
+// WithPoolPerformance enables shipping the latest performance sample of every pool.
+func WithPoolPerformance() ShipperOpts {
+	return func(s *Shipper) {
+		s.performance = true
+	}
+}
+
+// WithErrorHandler sets a function that is called with errors in Run.
+func WithErrorHandler(fn func(error)) ShipperOpts {
+	return func(s *Shipper) {
+		s.onError = fn
+	}
+}
+
+// Shipper periodically collects stats of pools and miners and sends them to a sink.
+type Shipper struct {
+	client      *miningcore.Client
+	pools       []string
+	miners      []Miner
+	encoder     Encoder
+	sink        Sink
+	interval    time.Duration
+	performance bool
+	onError     func(error)
+	now         func() time.Time
+}
+
+// NewShipper creates a new shipper for the given pool ids.
+func NewShipper(c *miningcore.Client, pools []string, enc Encoder, sink Sink, opts ...ShipperOpts) *Shipper {
+	s := &Shipper{
+		client:   c,
+		pools:    pools,
+		encoder:  enc,
+		sink:     sink,
+		interval: time.Minute,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Run collects and ships the stats until the context is canceled.
+func (s *Shipper) Run(ctx context.Context) error {
+	return poll.Run(ctx, s.interval, s.Ship, s.onError)
+}
+
+// Ship collects the current stats once and sends them to the sink.
+func (s *Shipper) Ship(ctx context.Context) error {
+	points, err := s.Collect(ctx)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := s.encoder.Encode(&buf, points); err != nil {
+		return err
+	}
+	if buf.Len() == 0 {
+		return nil
+	}
+	return s.sink.Send(ctx, buf.Bytes())
+}
+
+// Collect fetches the current stats of all pools and miners.
+func (s *Shipper) Collect(ctx context.Context) ([]Point, error) {
+	now := s.now()
+	pools := make(map[string]*miningcore.PoolInfo)
+	var points []Point
+	for _, id := range s.pools {
+		pool, _, err := s.client.GetPool(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		pools[id] = pool
+		points = append(points, PoolPoints(pool, now)...)
+		if !s.performance {
+			continue
+		}
+		samples, _, err := s.client.GetPerformance(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(samples) > 0 {
+			p, err := PoolPerformancePoints(pool, samples[len(samples)-1:])
+			if err != nil {
+				return nil, err
+			}
+			points = append(points, p...)
+		}
+	}
+	for _, m := range s.miners {
+		pool, ok := pools[m.PoolID]
+		if !ok {
+			var err error
+			if pool, _, err = s.client.GetPool(ctx, m.PoolID); err != nil {
+				return nil, err
+			}
+			pools[m.PoolID] = pool
+		}
+		stats, _, err := s.client.GetMiner(ctx, m.PoolID, m.Address)
+		if err != nil {
+			return nil, err
+		}
+		points = append(points, WorkerPoints(pool, m.Address, stats.Performance, now)...)
+	}
+	return points, nil
+}
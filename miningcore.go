@@ -2,7 +2,6 @@ package miningcore
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 )
 
@@ -36,7 +35,7 @@ func (c *Client) GetPool(ctx context.Context, id string) (*PoolInfo, int, error)
 }
 
 func (c *Client) UnmarshalPool(ctx context.Context, id string, res any) (int, error) {
-	e := "/api/pools/" + id
+	e := endpoint("/api/pools/%s", id)
 	return c.doRequest(ctx, e, http.MethodGet, res, nil)
 }
 
@@ -52,7 +51,7 @@ func (c *Client) GetPoolBlocks(ctx context.Context, id string, params ...map[str
 }
 
 func (c *Client) UnmarshalPoolBlocks(ctx context.Context, id string, res any, params ...map[string]string) (int, error) {
-	e := fmt.Sprintf("/api/v2/pools/%s/blocks", id)
+	e := endpoint("/api/v2/pools/%s/blocks", id)
 	return c.doRequest(ctx, e, http.MethodGet, res, nil, params...)
 }
 
@@ -68,7 +67,7 @@ func (c *Client) GetPoolPayments(ctx context.Context, id string, params ...map[s
 }
 
 func (c *Client) UnmarshalPoolPayments(ctx context.Context, id string, res any, params ...map[string]string) (int, error) {
-	e := fmt.Sprintf("/api/v2/pools/%s/payments", id)
+	e := endpoint("/api/v2/pools/%s/payments", id)
 	return c.doRequest(ctx, e, http.MethodGet, res, nil, params...)
 }
 
@@ -84,7 +83,7 @@ func (c *Client) GetMiners(ctx context.Context, id string, params ...map[string]
 }
 
 func (c *Client) UnmarshalMiners(ctx context.Context, id string, res any, params ...map[string]string) (int, error) {
-	e := fmt.Sprintf("/api/pools/%s/miners", id)
+	e := endpoint("/api/pools/%s/miners", id)
 	return c.doRequest(ctx, e, http.MethodGet, res, nil, params...)
 }
 
@@ -104,7 +103,11 @@ func (c *Client) GetMiner(ctx context.Context, id, addr string, params ...map[st
 }
 
 func (c *Client) UnmarshalMiner(ctx context.Context, id, addr string, res any, params ...map[string]string) (int, error) {
-	e := fmt.Sprintf("/api/pools/%s/miners/%s", id, addr)
+	addr, err := c.prepareAddress(ctx, id, addr)
+	if err != nil {
+		return 0, err
+	}
+	e := endpoint("/api/pools/%s/miners/%s", id, addr)
 	return c.doRequest(ctx, e, http.MethodGet, res, nil, params...)
 }
 
@@ -120,7 +123,11 @@ func (c *Client) GetMinerPayments(ctx context.Context, id, addr string, params .
 }
 
 func (c *Client) UnmarshalMinerPayments(ctx context.Context, id, addr string, res any, params ...map[string]string) (int, error) {
-	e := fmt.Sprintf("/api/v2/pools/%s/miners/%s/payments", id, addr)
+	addr, err := c.prepareAddress(ctx, id, addr)
+	if err != nil {
+		return 0, err
+	}
+	e := endpoint("/api/v2/pools/%s/miners/%s/payments", id, addr)
 	return c.doRequest(ctx, e, http.MethodGet, res, nil, params...)
 }
 
@@ -136,7 +143,11 @@ func (c *Client) GetMinerDailyEarnings(ctx context.Context, id, addr string, par
 }
 
 func (c *Client) UnmarshalMinerDailyEarnings(ctx context.Context, id, addr string, res any, params ...map[string]string) (int, error) {
-	e := fmt.Sprintf("/api/v2/pools/%s/miners/%s/earnings/daily", id, addr)
+	addr, err := c.prepareAddress(ctx, id, addr)
+	if err != nil {
+		return 0, err
+	}
+	e := endpoint("/api/v2/pools/%s/miners/%s/earnings/daily", id, addr)
 	return c.doRequest(ctx, e, http.MethodGet, res, nil, params...)
 }
 
@@ -152,12 +163,16 @@ func (c *Client) GetMinerBalanceChanges(ctx context.Context, id, addr string, pa
 }
 
 func (c *Client) UnmarshalMinerBalanceChanges(ctx context.Context, id, addr string, res any, params ...map[string]string) (int, error) {
-	e := fmt.Sprintf("/api/v2/pools/%s/miners/%s/balancechanges", id, addr)
+	addr, err := c.prepareAddress(ctx, id, addr)
+	if err != nil {
+		return 0, err
+	}
+	e := endpoint("/api/v2/pools/%s/miners/%s/balancechanges", id, addr)
 	return c.doRequest(ctx, e, http.MethodGet, res, nil, params...)
 }
 
 // GetMinerPerformance returns a list of performance samples of a miner.
-// This endpoints allows to specify the sample range using the`sampleRange` parameter.
+// This endpoints allows to specify the sample range using the `mode` parameter.
 // Possible values are:
 // 		"Hour"
 // 		"Day"
@@ -168,12 +183,16 @@ func (c *Client) GetMinerPerformance(ctx context.Context, id, addr string, param
 	if err != nil {
 		return nil, s, err
 	}
-	return nil, 0, nil
+	return res, s, nil
 }
 
 func (c *Client) UnmarshalMinerPerformance(ctx context.Context, id, addr string, res any, params ...map[string]string) (int, error) {
-	e := fmt.Sprintf("/api/pools/%s/miners/%s/performance", id, addr)
-	return c.doRequest(ctx, e, http.MethodGet, res, nil)
+	addr, err := c.prepareAddress(ctx, id, addr)
+	if err != nil {
+		return 0, err
+	}
+	e := endpoint("/api/pools/%s/miners/%s/performance", id, addr)
+	return c.doRequest(ctx, e, http.MethodGet, res, nil, params...)
 }
 
 // GetMinerSettings returns the current miner settings of a pool.
@@ -187,7 +206,11 @@ func (c *Client) GetMinerSettings(ctx context.Context, id, addr string) (*MinerS
 }
 
 func (c *Client) UnmarshalMinerSettings(ctx context.Context, id, addr string, res any) (int, error) {
-	e := fmt.Sprintf("/api/pools/%s/miners/%s/settings", id, addr)
+	addr, err := c.prepareAddress(ctx, id, addr)
+	if err != nil {
+		return 0, err
+	}
+	e := endpoint("/api/pools/%s/miners/%s/settings", id, addr)
 	return c.doRequest(ctx, e, http.MethodGet, res, nil)
 }
 
@@ -202,7 +225,11 @@ func (c *Client) PostMinerSettings(ctx context.Context, id, addr string, setting
 }
 
 func (c *Client) UnmarshalPostMinerSettings(ctx context.Context, id, addr string, settings any, res any) (int, error) {
-	e := fmt.Sprintf("/api/pools/%s/miners/%s/settings", id, addr)
+	addr, err := c.prepareAddress(ctx, id, addr)
+	if err != nil {
+		return 0, err
+	}
+	e := endpoint("/api/pools/%s/miners/%s/settings", id, addr)
 	return c.doRequest(ctx, e, http.MethodPost, res, settings)
 }
 
@@ -227,6 +254,6 @@ func (c *Client) GetPerformance(ctx context.Context, id string, params ...map[st
 }
 
 func (c *Client) UnmarshalPoolPerformance(ctx context.Context, id string, res any, params ...map[string]string) (int, error) {
-	e := fmt.Sprintf("/api/pools/%s/performance", id)
+	e := endpoint("/api/pools/%s/performance", id)
 	return c.doRequest(ctx, e, http.MethodGet, res, nil, params...)
 }
@@ -0,0 +1,257 @@
+package miningcore
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+)
+
+// ErrInvalidAddress is returned if a miner address is not valid for the coin family of a pool.
+var ErrInvalidAddress = errors.New("invalid address")
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// ValidateAddress checks if addr is a valid address for the given coin family.
+// Addresses of unknown families are only checked for being non-empty.
+func ValidateAddress(family CoinFamily, addr string) error {
+	if addr == "" {
+		return fmt.Errorf("%w: empty address", ErrInvalidAddress)
+	}
+	var err error
+	switch family {
+	case FamilyBitcoin:
+		if isBech32(addr) {
+			err = validateBech32(addr)
+		} else {
+			err = validateBase58Check(addr)
+		}
+	case FamilyEquihash:
+		err = validateBase58Check(addr)
+	case FamilyEthereum:
+		err = validateEthereum(addr)
+	case FamilyCryptonote:
+		err = validateCryptonote(addr)
+	case FamilyErgo:
+		err = validateErgo(addr)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidAddress, addr, err)
+	}
+	return nil
+}
+
+// NormalizeAddress trims whitespace, strips a worker suffix ("addr.worker") and
+// lowercases ethereum addresses the same way miningcore stores them.
+func NormalizeAddress(family CoinFamily, addr string) string {
+	addr = strings.TrimSpace(addr)
+	if i := strings.IndexByte(addr, '.'); i >= 0 {
+		addr = addr[:i]
+	}
+	if family == FamilyEthereum {
+		addr = strings.ToLower(addr)
+	}
+	return addr
+}
+
+func validateEthereum(addr string) error {
+	if !strings.HasPrefix(addr, "0x") && !strings.HasPrefix(addr, "0X") {
+		return errors.New("missing 0x prefix")
+	}
+	hexPart := addr[2:]
+	if len(hexPart) != 40 {
+		return errors.New("wrong length")
+	}
+	if _, err := hex.DecodeString(hexPart); err != nil {
+		return errors.New("not hex encoded")
+	}
+	if hexPart == strings.ToLower(hexPart) || hexPart == strings.ToUpper(hexPart) {
+		return nil
+	}
+
+	// mixed case addresses carry an EIP-55 checksum
+	h := sha3.NewLegacyKeccak256()
+	h.Write([]byte(strings.ToLower(hexPart)))
+	sum := hex.EncodeToString(h.Sum(nil))
+	for i, c := range hexPart {
+		if c >= '0' && c <= '9' {
+			continue
+		}
+		if (c >= 'A' && c <= 'F') != (sum[i] >= '8') {
+			return errors.New("checksum mismatch")
+		}
+	}
+	return nil
+}
+
+func decodeBase58(s string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for _, c := range []byte(s) {
+		i := strings.IndexByte(base58Alphabet, c)
+		if i < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", c)
+		}
+		n.Mul(n, radix)
+		n.Add(n, big.NewInt(int64(i)))
+	}
+	decoded := n.Bytes()
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	return append(make([]byte, zeros), decoded...), nil
+}
+
+func validateBase58Check(addr string) error {
+	data, err := decodeBase58(addr)
+	if err != nil {
+		return err
+	}
+	if len(data) < 5 {
+		return errors.New("too short")
+	}
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	if !bytes.Equal(second[:4], checksum) {
+		return errors.New("checksum mismatch")
+	}
+	return nil
+}
+
+func validateErgo(addr string) error {
+	data, err := decodeBase58(addr)
+	if err != nil {
+		return err
+	}
+	if len(data) < 5 {
+		return errors.New("too short")
+	}
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	sum := blake2b.Sum256(payload)
+	if !bytes.Equal(sum[:4], checksum) {
+		return errors.New("checksum mismatch")
+	}
+	return nil
+}
+
+// cryptonote base58 encodes 8 byte blocks into 11 characters, the last block may be shorter
+var cryptonoteBlockSizes = []int{0, -1, 1, 2, -1, 3, 4, 5, -1, 6, 7, 8}
+
+func validateCryptonote(addr string) error {
+	var data []byte
+	for i := 0; i < len(addr); i += 11 {
+		end := i + 11
+		if end > len(addr) {
+			end = len(addr)
+		}
+		size := cryptonoteBlockSizes[end-i]
+		if size < 0 {
+			return errors.New("invalid length")
+		}
+		block, err := decodeBase58(addr[i:end])
+		if err != nil {
+			return err
+		}
+		block = bytes.TrimLeft(block, "\x00")
+		if len(block) > size {
+			return errors.New("invalid block")
+		}
+		data = append(data, make([]byte, size-len(block))...)
+		data = append(data, block...)
+	}
+	if len(data) < 5 {
+		return errors.New("too short")
+	}
+	payload, checksum := data[:len(data)-4], data[len(data)-4:]
+	h := sha3.NewLegacyKeccak256()
+	h.Write(payload)
+	if !bytes.Equal(h.Sum(nil)[:4], checksum) {
+		return errors.New("checksum mismatch")
+	}
+	return nil
+}
+
+const bech32Charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+// isBech32 reports whether addr has the shape of a bech32 address of any coin: a single case,
+// a lowercase letter prefix and a data part of bech32 characters after the last '1'. Base58
+// addresses are told apart by their mixed case.
+func isBech32(addr string) bool {
+	if strings.ToLower(addr) != addr && strings.ToUpper(addr) != addr {
+		return false
+	}
+	addr = strings.ToLower(addr)
+	i := strings.LastIndexByte(addr, '1')
+	if i < 1 || i+7 > len(addr) {
+		return false
+	}
+	for _, c := range addr[:i] {
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	for _, c := range []byte(addr[i+1:]) {
+		if strings.IndexByte(bech32Charset, c) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func validateBech32(addr string) error {
+	if len(addr) > 90 {
+		return errors.New("too long")
+	}
+	if strings.ToLower(addr) != addr && strings.ToUpper(addr) != addr {
+		return errors.New("mixed case")
+	}
+	addr = strings.ToLower(addr)
+	sep := strings.LastIndexByte(addr, '1')
+	if sep < 1 || sep+7 > len(addr) {
+		return errors.New("invalid separator position")
+	}
+	hrp := addr[:sep]
+	values := make([]int, 0, len(hrp)*2+1+len(addr)-sep-1)
+	for _, c := range hrp {
+		values = append(values, int(c>>5))
+	}
+	values = append(values, 0)
+	for _, c := range hrp {
+		values = append(values, int(c&31))
+	}
+	for _, c := range []byte(addr[sep+1:]) {
+		i := strings.IndexByte(bech32Charset, c)
+		if i < 0 {
+			return fmt.Errorf("invalid bech32 character %q", c)
+		}
+		values = append(values, i)
+	}
+	switch bech32Polymod(values) {
+	case 1, 0x2bc830a3: // bech32, bech32m
+		return nil
+	}
+	return errors.New("checksum mismatch")
+}
+
+func bech32Polymod(values []int) int {
+	gen := []int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := 1
+	for _, v := range values {
+		b := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ v
+		for i := 0; i < 5; i++ {
+			if (b>>uint(i))&1 == 1 {
+				chk ^= gen[i]
+			}
+		}
+	}
+	return chk
+}
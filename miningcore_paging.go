@@ -0,0 +1,140 @@
+package miningcore
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// DefaultPageSize is the page size used by Paginate if none is given.
+const DefaultPageSize = 100
+
+// ErrLastPage is returned by a PageFunc along with the items of the last page
+// if the endpoint reports its page count.
+var ErrLastPage = errors.New("last page")
+
+// PageFunc fetches a single page of a paged endpoint.
+type PageFunc[T any] func(ctx context.Context, params map[string]string) ([]T, error)
+
+// Paginate requests the pages of a paged endpoint one after another and calls fn for every item.
+// It stops after the last page or as soon as fn returns false or an error.
+func Paginate[T any](ctx context.Context, pageSize int, fetch PageFunc[T], fn func(T) (bool, error)) error {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	for page := 0; ; page++ {
+		items, err := fetch(ctx, map[string]string{
+			"page":     strconv.Itoa(page),
+			"pageSize": strconv.Itoa(pageSize),
+		})
+		last := errors.Is(err, ErrLastPage)
+		if err != nil && !last {
+			return err
+		}
+		for _, item := range items {
+			cont, err := fn(item)
+			if err != nil || !cont {
+				return err
+			}
+		}
+		if last || len(items) < pageSize {
+			return nil
+		}
+	}
+}
+
+// Collect pages through an endpoint that lists its items newest first and returns the items
+// created between from and to. A zero to means no upper limit. created returns the timestamp of an item.
+func Collect[T any](ctx context.Context, fetch PageFunc[T], from, to time.Time, created func(T) string) ([]T, error) {
+	var res []T
+	err := Paginate(ctx, 0, fetch, func(item T) (bool, error) {
+		t, err := ParseTime(created(item))
+		if err != nil {
+			return false, err
+		}
+		if t.Before(from) {
+			return false, nil
+		}
+		if to.IsZero() || t.Before(to) {
+			res = append(res, item)
+		}
+		return true, nil
+	})
+	return res, err
+}
+
+// lastPage returns ErrLastPage if the requested page is the last one according to meta.
+func lastPage(meta *Meta, params map[string]string) error {
+	if meta == nil || meta.PageCount <= 0 {
+		return nil
+	}
+	page, err := strconv.ParseInt(params["page"], 10, 64)
+	if err != nil || page+1 < meta.PageCount {
+		return nil
+	}
+	return ErrLastPage
+}
+
+// PoolBlocksPages returns a PageFunc for the blocks of a pool.
+func (c *Client) PoolBlocksPages(id string) PageFunc[*Block] {
+	return func(ctx context.Context, params map[string]string) ([]*Block, error) {
+		res, _, err := c.GetPoolBlocks(ctx, id, params)
+		if err != nil {
+			return nil, err
+		}
+		return res.Result, lastPage(res.Meta, params)
+	}
+}
+
+// PoolPaymentsPages returns a PageFunc for the payments of a pool.
+func (c *Client) PoolPaymentsPages(id string) PageFunc[*Payment] {
+	return func(ctx context.Context, params map[string]string) ([]*Payment, error) {
+		res, _, err := c.GetPoolPayments(ctx, id, params)
+		if err != nil {
+			return nil, err
+		}
+		return res.Result, lastPage(res.Meta, params)
+	}
+}
+
+// MinerPaymentsPages returns a PageFunc for the payments of a miner.
+func (c *Client) MinerPaymentsPages(id, addr string) PageFunc[*Payment] {
+	return func(ctx context.Context, params map[string]string) ([]*Payment, error) {
+		res, _, err := c.GetMinerPayments(ctx, id, addr, params)
+		if err != nil {
+			return nil, err
+		}
+		return res.Result, lastPage(res.Meta, params)
+	}
+}
+
+// MinerBalanceChangesPages returns a PageFunc for the balance changes of a miner.
+func (c *Client) MinerBalanceChangesPages(id, addr string) PageFunc[*BalanceChange] {
+	return func(ctx context.Context, params map[string]string) ([]*BalanceChange, error) {
+		res, _, err := c.GetMinerBalanceChanges(ctx, id, addr, params)
+		if err != nil {
+			return nil, err
+		}
+		return res.Result, lastPage(res.Meta, params)
+	}
+}
+
+// MinerDailyEarningsPages returns a PageFunc for the daily earnings of a miner.
+func (c *Client) MinerDailyEarningsPages(id, addr string) PageFunc[*DailyEarning] {
+	return func(ctx context.Context, params map[string]string) ([]*DailyEarning, error) {
+		res, _, err := c.GetMinerDailyEarnings(ctx, id, addr, params)
+		if err != nil {
+			return nil, err
+		}
+		return res.Result, lastPage(res.Meta, params)
+	}
+}
+
+// MinersPages returns a PageFunc for the miners of a pool.
+func (c *Client) MinersPages(id string) PageFunc[*MinerPerformanceStats] {
+	return func(ctx context.Context, params map[string]string) ([]*MinerPerformanceStats, error) {
+		res, _, err := c.GetMiners(ctx, id, params)
+		return res, err
+	}
+}
@@ -0,0 +1,208 @@
+package miningcore
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// PayoutScheme is the reward system used by a pool.
+type PayoutScheme string
+
+const (
+	PayoutPPLNS   PayoutScheme = "PPLNS"
+	PayoutPPLNSBF PayoutScheme = "PPLNSBF"
+	PayoutPROP    PayoutScheme = "PROP"
+	PayoutSOLO    PayoutScheme = "SOLO"
+	PayoutPPS     PayoutScheme = "PPS"
+)
+
+// CoinFamily is the family of a coin as reported by miningcore.
+type CoinFamily string
+
+const (
+	FamilyBitcoin    CoinFamily = "bitcoin"
+	FamilyEquihash   CoinFamily = "equihash"
+	FamilyCryptonote CoinFamily = "cryptonote"
+	FamilyEthereum   CoinFamily = "ethereum"
+	FamilyErgo       CoinFamily = "ergo"
+)
+
+// PayoutSchemeConfig is the scheme specific part of the payment processing config.
+type PayoutSchemeConfig interface {
+	Scheme() PayoutScheme
+}
+
+// PPLNSConfig is the payout scheme config of a PPLNS pool.
+// Factor is the multiplier of the network difficulty that makes up the share window.
+type PPLNSConfig struct {
+	Factor float64 `json:"factor"`
+}
+
+// Scheme implements PayoutSchemeConfig.
+func (PPLNSConfig) Scheme() PayoutScheme { return PayoutPPLNS }
+
+// PPLNSBFConfig is the payout scheme config of a PPLNS pool with a block finder bonus.
+type PPLNSBFConfig struct {
+	Factor                float64 `json:"factor"`
+	BlockFinderPercentage float64 `json:"blockFinderPercentage"`
+}
+
+// Scheme implements PayoutSchemeConfig.
+func (PPLNSBFConfig) Scheme() PayoutScheme { return PayoutPPLNSBF }
+
+// PROPConfig is the payout scheme config of a proportional pool.
+type PROPConfig struct{}
+
+// Scheme implements PayoutSchemeConfig.
+func (PROPConfig) Scheme() PayoutScheme { return PayoutPROP }
+
+// SOLOConfig is the payout scheme config of a solo pool.
+type SOLOConfig struct{}
+
+// Scheme implements PayoutSchemeConfig.
+func (SOLOConfig) Scheme() PayoutScheme { return PayoutSOLO }
+
+// PPSConfig is the payout scheme config of a pay per share pool.
+type PPSConfig struct{}
+
+// Scheme implements PayoutSchemeConfig.
+func (PPSConfig) Scheme() PayoutScheme { return PayoutPPS }
+
+// PaymentProcessingExtra is the coin family specific part of the payment processing config.
+type PaymentProcessingExtra interface {
+	CoinFamily() CoinFamily
+}
+
+// BitcoinPaymentExtra holds the payment extras of bitcoin family pools.
+type BitcoinPaymentExtra struct {
+	MinersPayTxFees bool `json:"minersPayTxFees"`
+}
+
+// CoinFamily implements PaymentProcessingExtra.
+func (BitcoinPaymentExtra) CoinFamily() CoinFamily { return FamilyBitcoin }
+
+// EquihashPaymentExtra holds the payment extras of equihash family pools.
+type EquihashPaymentExtra struct {
+	MinersPayTxFees bool `json:"minersPayTxFees"`
+}
+
+// CoinFamily implements PaymentProcessingExtra.
+func (EquihashPaymentExtra) CoinFamily() CoinFamily { return FamilyEquihash }
+
+// EthereumPaymentExtra holds the payment extras of ethereum family pools.
+type EthereumPaymentExtra struct {
+	KeepUncles          bool   `json:"keepUncles"`
+	KeepTransactionFees bool   `json:"keepTransactionFees"`
+	Gas                 uint64 `json:"gas"`
+	MaxFeePerGas        uint64 `json:"maxFeePerGas"`
+}
+
+// CoinFamily implements PaymentProcessingExtra.
+func (EthereumPaymentExtra) CoinFamily() CoinFamily { return FamilyEthereum }
+
+// CryptonotePaymentExtra holds the payment extras of cryptonote family pools.
+type CryptonotePaymentExtra struct {
+	MinimumPaymentToPaymentID float64 `json:"minimumPaymentToPaymentId"`
+}
+
+// CoinFamily implements PaymentProcessingExtra.
+func (CryptonotePaymentExtra) CoinFamily() CoinFamily { return FamilyCryptonote }
+
+// ErgoPaymentExtra holds the payment extras of ergo family pools.
+type ErgoPaymentExtra struct {
+	MinersPayTxFees bool `json:"minersPayTxFees"`
+}
+
+// CoinFamily implements PaymentProcessingExtra.
+func (ErgoPaymentExtra) CoinFamily() CoinFamily { return FamilyErgo }
+
+// UnmarshalJSON decodes the payment processing config and picks the payout scheme config
+// variant by PayoutScheme. Configs that are not JSON objects are ignored.
+func (p *APIPoolPaymentProcessingConfig) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Enabled            bool                   `json:"enabled"`
+		MinimumPayment     float64                `json:"minimumPayment"`
+		PayoutScheme       PayoutScheme           `json:"payoutScheme"`
+		PayoutSchemeConfig json.RawMessage        `json:"payoutSchemeConfig"`
+		Extra              map[string]interface{} `json:"extra"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	p.Enabled = raw.Enabled
+	p.MinimumPayment = raw.MinimumPayment
+	p.PayoutScheme = raw.PayoutScheme
+	p.Extra = raw.Extra
+	p.PayoutSchemeConfig = nil
+
+	cfg := bytes.TrimSpace(raw.PayoutSchemeConfig)
+	if len(cfg) == 0 || cfg[0] != '{' {
+		return nil
+	}
+	var v PayoutSchemeConfig
+	switch raw.PayoutScheme {
+	case PayoutPPLNS:
+		v = &PPLNSConfig{}
+	case PayoutPPLNSBF:
+		v = &PPLNSBFConfig{}
+	case PayoutPROP:
+		v = &PROPConfig{}
+	case PayoutSOLO:
+		v = &SOLOConfig{}
+	case PayoutPPS:
+		v = &PPSConfig{}
+	default:
+		return nil
+	}
+	if err := json.Unmarshal(cfg, v); err != nil {
+		return err
+	}
+	p.PayoutSchemeConfig = v
+	return nil
+}
+
+// UnmarshalJSON decodes the pool info and attaches the typed payment extras for the coin family.
+func (p *PoolInfo) UnmarshalJSON(data []byte) error {
+	type poolInfo PoolInfo
+	if err := json.Unmarshal(data, (*poolInfo)(p)); err != nil {
+		return err
+	}
+	if p.PaymentProcessing == nil || p.Coin == nil {
+		return nil
+	}
+	extra, err := decodePaymentExtra(p.Coin.Family, p.PaymentProcessing.Extra)
+	if err != nil {
+		return err
+	}
+	p.PaymentProcessing.FamilyExtra = extra
+	return nil
+}
+
+func decodePaymentExtra(family CoinFamily, extra map[string]interface{}) (PaymentProcessingExtra, error) {
+	var v PaymentProcessingExtra
+	switch family {
+	case FamilyBitcoin:
+		v = &BitcoinPaymentExtra{}
+	case FamilyEquihash:
+		v = &EquihashPaymentExtra{}
+	case FamilyEthereum:
+		v = &EthereumPaymentExtra{}
+	case FamilyCryptonote:
+		v = &CryptonotePaymentExtra{}
+	case FamilyErgo:
+		v = &ErgoPaymentExtra{}
+	default:
+		return nil, nil
+	}
+	if len(extra) == 0 {
+		return v, nil
+	}
+	data, err := json.Marshal(extra)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
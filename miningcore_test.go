@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -99,3 +100,258 @@ func TestPoolMock(t *testing.T) {
 	assert.Equal(t, http.StatusForbidden, code)
 	assert.Nil(t, pool)
 }
+
+func TestPayoutSchemeConfig(t *testing.T) {
+	var pool PoolInfo
+	data := `{
+		"id": "eth",
+		"coin": {"family": "ethereum"},
+		"ports": {"420": {"varDiff": {"minDiff": 2, "maxDiff": 8}}},
+		"paymentProcessing": {
+			"payoutScheme": "PPLNS",
+			"payoutSchemeConfig": {"factor": 2.5},
+			"extra": {"keepUncles": true, "gas": 21000}
+		}
+	}`
+	assert.NoError(t, json.Unmarshal([]byte(data), &pool))
+	assert.Equal(t, &PPLNSConfig{Factor: 2.5}, pool.PaymentProcessing.PayoutSchemeConfig)
+	assert.Equal(t, &EthereumPaymentExtra{KeepUncles: true, Gas: 21000}, pool.PaymentProcessing.FamilyExtra)
+	assert.Equal(t, Difficulty(8), *pool.Ports["420"].VarDiff.MaxDiff)
+	assert.Nil(t, pool.Ports["420"].VarDiff.MaxDelta)
+}
+
+func TestEquihashPaymentExtra(t *testing.T) {
+	var pool PoolInfo
+	data := `{"coin": {"family": "equihash"}, "paymentProcessing": {"extra": {"minersPayTxFees": true}}}`
+	assert.NoError(t, json.Unmarshal([]byte(data), &pool))
+	assert.Equal(t, &EquihashPaymentExtra{MinersPayTxFees: true}, pool.PaymentProcessing.FamilyExtra)
+	assert.Equal(t, FamilyEquihash, pool.PaymentProcessing.FamilyExtra.CoinFamily())
+}
+
+func TestSortedPorts(t *testing.T) {
+	pool := PoolInfo{Ports: map[string]PoolEndpoint{
+		"5000":  {Name: "high"},
+		"420":   {Name: "low"},
+		"x":     {Name: "invalid"},
+		"70000": {Name: "out of range"},
+	}}
+	ports := pool.SortedPorts()
+	assert.Len(t, ports, 2)
+	assert.Equal(t, 420, ports[0].Number)
+	assert.Equal(t, "low", ports[0].Name)
+	assert.Equal(t, 5000, ports[1].Number)
+}
+
+func TestDialHost(t *testing.T) {
+	assert.Equal(t, "localhost", PoolEndpoint{ListenAddress: "0.0.0.0"}.DialHost())
+	assert.Equal(t, "localhost", PoolEndpoint{}.DialHost())
+	assert.Equal(t, "::1", PoolEndpoint{ListenAddress: "[::1]"}.DialHost())
+	assert.Equal(t, "10.0.0.1", PoolEndpoint{ListenAddress: "10.0.0.1"}.DialHost())
+}
+
+func TestPayoutSchemeConfigNotAnObject(t *testing.T) {
+	pool, _, err := newClient().GetPool(context.Background(), "eth")
+	assert.NoError(t, err)
+	assert.Equal(t, PayoutPPLNS, pool.PaymentProcessing.PayoutScheme)
+	assert.Nil(t, pool.PaymentProcessing.PayoutSchemeConfig)
+}
+
+func TestMinerPerformance(t *testing.T) {
+	var mode string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/pools/eth/miners/0xabc/performance", r.URL.Path)
+		mode = r.URL.Query().Get("mode")
+		json.NewEncoder(w).Encode([]*WorkerStats{
+			{Created: "2022-07-01T00:00:00Z", Workers: map[string]*WorkerPerformanceStats{"rig1": {Hashrate: 100}}},
+		})
+	}))
+	defer ts.Close()
+
+	samples, code, err := New(ts.URL).GetMinerPerformance(context.Background(), "eth", "0xabc", map[string]string{"mode": "Month"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "Month", mode)
+	assert.Len(t, samples, 1)
+	assert.Equal(t, Hashrate(100), samples[0].Workers["rig1"].Hashrate)
+}
+
+func TestNotificationPoolID(t *testing.T) {
+	assert.Equal(t, "eth", PoolID(&BlockFoundMessage{BlockMessage: BlockMessage{PoolID: "eth"}}))
+	assert.Equal(t, "eth", PoolID(PaymentMessage{PoolID: "eth"}))
+	assert.Equal(t, "btc", PoolID(HashRateUpdateMessage{PoolID: "btc"}))
+}
+
+func TestValidateAddress(t *testing.T) {
+	valid := []struct {
+		family CoinFamily
+		addr   string
+	}{
+		{FamilyBitcoin, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa"},
+		{FamilyBitcoin, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"},
+		{FamilyBitcoin, "bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0"},
+		{FamilyBitcoin, "dgb1qw508d6qejxtdg4y5r3zarvary0c5xw7kmudfnm"},
+		{FamilyBitcoin, "VTC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KUK9R06"},
+		{FamilyEthereum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{FamilyEthereum, "0x000000000000000000000000000000000000dead"},
+		{FamilyCryptonote, "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3A"},
+		{FamilyErgo, "9eYPYgcpVXL9SuvDeWJ5LyEMqMFgtX34FSjfnYw79uBp5tUjoMv"},
+		{"unknown", "anything"},
+	}
+	for _, v := range valid {
+		assert.NoError(t, ValidateAddress(v.family, v.addr), v.addr)
+	}
+
+	invalid := []struct {
+		family CoinFamily
+		addr   string
+	}{
+		{FamilyBitcoin, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb"},
+		{FamilyBitcoin, "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5"},
+		{FamilyBitcoin, "dgb1qw508d6qejxtdg4y5r3zarvary0c5xw7kmudfnn"},
+		{FamilyEthereum, "0x5aaeb6053F3E94C9b9A09f33669435E7Ef1BeAed"},
+		{FamilyEthereum, "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed.rig1"},
+		{FamilyCryptonote, "44AFFq5kSiGBoZ4NMDwYtN18obc8AemS33DBLWs3H7otXft3XjrpDtQGv7SqSsaBYBb98uNbr2VBBEt7f2wfn3RVGQBEP3B"},
+		{FamilyErgo, "9eYPYgcpVXL9SuvDeWJ5LyEMqMFgtX34FSjfnYw79uBp5tUjoMw"},
+		{FamilyBitcoin, ""},
+	}
+	for _, v := range invalid {
+		assert.ErrorIs(t, ValidateAddress(v.family, v.addr), ErrInvalidAddress, v.addr)
+	}
+}
+
+func TestNormalizeAddress(t *testing.T) {
+	assert.Equal(t, "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed", NormalizeAddress(FamilyEthereum, " 0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed.rig1 "))
+	assert.Equal(t, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", NormalizeAddress(FamilyBitcoin, "1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa.worker"))
+}
+
+func TestMinerAddressValidation(t *testing.T) {
+	client := New(testServer.URL, WithAddressValidation())
+	_, code, err := client.GetMiner(context.Background(), "eth", "0xnotanaddress")
+	assert.ErrorIs(t, err, ErrInvalidAddress)
+	assert.Equal(t, 0, code)
+}
+
+func TestBuildRequestUrlEscaping(t *testing.T) {
+	url, err := buildRequestURL("http://localhost:8080", endpoint("/api/pools/%s/miners/%s", "eth", "a/b?c"))
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080/api/pools/eth/miners/a%2Fb%3Fc", url)
+}
+
+func TestHashrate(t *testing.T) {
+	assert.Equal(t, "20.00 GH/s", Hashrate(20000000000).String())
+	assert.Equal(t, "512.00 H/s", Hashrate(512).String())
+	assert.Equal(t, "1.50 kSol/s", Hashrate(1500).Format("Equihash"))
+
+	for in, exp := range map[string]Hashrate{
+		"4.3 GH/s":  4.3e9,
+		"4.3GH/s":   4.3e9,
+		"4.3 g":     4.3e9,
+		"12 kSol/s": 12e3,
+		"100":       100,
+		"1 EH/s":    1e18,
+	} {
+		h, err := ParseHashrate(in)
+		assert.NoError(t, err, in)
+		assert.InDelta(t, float64(exp), float64(h), 1, in)
+	}
+	_, err := ParseHashrate("4.3 XH/s")
+	assert.Error(t, err)
+	_, err = ParseHashrate("fast")
+	assert.Error(t, err)
+}
+
+func TestDifficulty(t *testing.T) {
+	d := Difficulty(1)
+	assert.Equal(t, float64(1<<32), d.ExpectedHashes(FamilyBitcoin))
+	assert.Equal(t, 1.0, d.ExpectedHashes(FamilyEthereum))
+	assert.Equal(t, 10*time.Second, Difficulty(1e9).ExpectedTime(1e8, FamilyEthereum))
+	assert.Equal(t, "12.02 P", Difficulty(12015683922023432).String())
+}
+
+func TestPaginate(t *testing.T) {
+	var pages []string
+	fetch := func(ctx context.Context, params map[string]string) ([]int, error) {
+		pages = append(pages, params["page"])
+		if params["page"] == "2" {
+			return []int{5}, nil
+		}
+		return []int{1, 2}, nil
+	}
+
+	var items []int
+	err := Paginate(context.Background(), 2, fetch, func(i int) (bool, error) {
+		items = append(items, i)
+		return true, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 1, 2, 5}, items)
+	assert.Equal(t, []string{"0", "1", "2"}, pages)
+
+	items = nil
+	err = Paginate(context.Background(), 2, fetch, func(i int) (bool, error) {
+		items = append(items, i)
+		return len(items) < 3, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 1}, items)
+}
+
+func TestPaginatePageCount(t *testing.T) {
+	var pages []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		pages = append(pages, r.URL.Query().Get("page"))
+		// full pages, only the page count tells that the second one is the last
+		json.NewEncoder(w).Encode(BlocksRes{
+			Meta:   &Meta{PageCount: 2, Success: true},
+			Result: []*Block{{BlockHeight: 2, Created: "2022-07-02T00:00:00Z"}, {BlockHeight: 1, Created: "2022-07-01T00:00:00Z"}},
+		})
+	}))
+	defer ts.Close()
+
+	var n int
+	err := Paginate(context.Background(), 2, New(ts.URL).PoolBlocksPages("eth"), func(b *Block) (bool, error) {
+		n++
+		return true, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, []string{"0", "1"}, pages)
+}
+
+func TestCollect(t *testing.T) {
+	fetch := func(ctx context.Context, params map[string]string) ([]string, error) {
+		return []string{"2022-07-04T00:00:00Z", "2022-07-03T00:00:00Z", "2022-07-02T00:00:00Z", "2022-07-01T00:00:00Z"}, nil
+	}
+	created := func(s string) string { return s }
+	from := time.Date(2022, 7, 2, 0, 0, 0, 0, time.UTC)
+
+	items, err := Collect(context.Background(), fetch, from, time.Time{}, created)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2022-07-04T00:00:00Z", "2022-07-03T00:00:00Z", "2022-07-02T00:00:00Z"}, items)
+
+	items, err = Collect(context.Background(), fetch, from, time.Date(2022, 7, 4, 0, 0, 0, 0, time.UTC), created)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2022-07-03T00:00:00Z", "2022-07-02T00:00:00Z"}, items)
+}
+
+func TestDecodeNotification(t *testing.T) {
+	n, err := DecodeNotification([]byte(`{"type":"blockfound","poolId":"eth","blockHeight":100,"miner":"0xabc"}`))
+	assert.NoError(t, err)
+	found := n.(*BlockFoundMessage)
+	assert.Equal(t, "eth", found.PoolID)
+	assert.Equal(t, uint64(100), found.BlockHeight)
+	assert.Equal(t, "0xabc", found.Miner)
+
+	n, err = DecodeNotification([]byte(`{"type":"payment","poolId":"eth","amount":1.5,"error":"insufficient funds"}`))
+	assert.NoError(t, err)
+	payment := n.(*PaymentMessage)
+	assert.Equal(t, 1.5, payment.Amount)
+	assert.EqualError(t, payment.Error, "insufficient funds")
+
+	n, err = DecodeNotification([]byte(`{"type":"greeting"}`))
+	assert.NoError(t, err)
+	assert.Nil(t, n)
+
+	_, err = DecodeNotification([]byte(`not json`))
+	assert.Error(t, err)
+}
@@ -1,5 +1,11 @@
 package miningcore
 
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
 type Meta struct {
 	PageCount           int64    `json:"pageCount"`
 	Success             bool     `json:"success"`
@@ -25,27 +31,32 @@ type PoolInfo struct {
 	TopMiners               []*MinerPerformanceStats        `json:"topMiners"`
 	TotalPaid               float64                         `json:"totalPaid"`
 	TotalBlocks             int32                           `json:"totalBlocks"`
+	TotalConfirmedBlocks    int32                           `json:"totalConfirmedBlocks"`
 	LastPoolBlockTime       string                          `json:"lastPoolBlockTime"`
+	PoolEffort              float64                         `json:"poolEffort"`
 	APIEndpoint             string                          `json:"apiEndpoint"`
 }
 
 type APICoinConfig struct {
-	Type          string `json:"type"`
-	Name          string `json:"name"`
-	Symbol        string `json:"symbol"`
-	Website       string `json:"website"`
-	Family        string `json:"family"`
-	Algorithm     string `json:"algorithm"`
-	Twitter       string `json:"twitter"`
-	Discord       string `json:"discord"`
-	Telegram      string `json:"telegram"`
-	CanonicalName string `json:"canonicalName"`
+	Type          string     `json:"type"`
+	Name          string     `json:"name"`
+	Symbol        string     `json:"symbol"`
+	Website       string     `json:"website"`
+	Market        string     `json:"market"`
+	Family        CoinFamily `json:"family"`
+	Algorithm     string     `json:"algorithm"`
+	Twitter       string     `json:"twitter"`
+	Discord       string     `json:"discord"`
+	Telegram      string     `json:"telegram"`
+	CanonicalName string     `json:"canonicalName"`
 }
 
+// PoolEndpoint is the config of a stratum port. TLSAuto means miningcore generates a self-signed
+// certificate, otherwise TLSPfxFile holds the certificate the port serves.
 type PoolEndpoint struct {
 	ListenAddress    string                  `json:"listenAddress"`
 	Name             string                  `json:"name"`
-	Difficulty       float64                 `json:"difficulty"`
+	Difficulty       Difficulty              `json:"difficulty"`
 	TCPProxyProtocol *TCPProxyProtocolConfig `json:"tcpProxyProtocol"`
 	VarDiff          *VarDiffConfig          `json:"varDiff"`
 	TLS              bool                    `json:"tls"`
@@ -54,26 +65,68 @@ type PoolEndpoint struct {
 	TLSPfxPassword   string                  `json:"tlsPfxPassword"`
 }
 
+// DialHost returns the host to connect to the port on: its listen address,
+// or localhost if it listens on all interfaces.
+func (e PoolEndpoint) DialHost() string {
+	switch e.ListenAddress {
+	case "", "*", "0.0.0.0", "::", "[::]":
+		return "localhost"
+	}
+	return strings.Trim(e.ListenAddress, "[]")
+}
+
+// TCPProxyProtocolConfig describes the PROXY protocol support of a stratum port. With Mandatory
+// set, connections without a PROXY header are dropped. ProxyAddresses are the addresses
+// allowed to send the header, miningcore only trusts localhost if it is empty.
 type TCPProxyProtocolConfig struct {
 	Enable         bool     `json:"enable"`
 	Mandatory      bool     `json:"mandatory"`
 	ProxyAddresses []string `json:"proxyAddresses"`
 }
 
+// VarDiffConfig describes the variable difficulty settings of a stratum port.
+// MaxDiff and MaxDelta are nil if the pool does not limit them.
 type VarDiffConfig struct {
-	MinDiff         float64 `json:"minDiff"`
-	MaxDiff         float64 `json:"maxDiff"`
-	MaxDelta        float64 `json:"maxDelta"`
-	TargetTime      float64 `json:"targetTime"`
-	RetargetTime    float64 `json:"retargetTime"`
-	VariancePercent float64 `json:"variancePercent"`
+	MinDiff         Difficulty  `json:"minDiff"`
+	MaxDiff         *Difficulty `json:"maxDiff"`
+	MaxDelta        *float64    `json:"maxDelta"`
+	TargetTime      float64     `json:"targetTime"`
+	RetargetTime    float64     `json:"retargetTime"`
+	VariancePercent float64     `json:"variancePercent"`
 }
 
+// APIPoolPaymentProcessingConfig describes the payment processing of a pool.
+// PayoutSchemeConfig holds the typed config matching PayoutScheme and is nil if the pool
+// does not expose one. FamilyExtra holds the typed view of Extra for the coin family of
+// the pool and is only set when decoded as part of a PoolInfo.
 type APIPoolPaymentProcessingConfig struct {
-	Enabled        bool                   `json:"enabled"`
-	MinimumPayment float64                `json:"minimumPayment"`
-	PayoutScheme   string                 `json:"payoutScheme"`
-	Extra          map[string]interface{} `json:"extra"`
+	Enabled            bool                   `json:"enabled"`
+	MinimumPayment     float64                `json:"minimumPayment"`
+	PayoutScheme       PayoutScheme           `json:"payoutScheme"`
+	PayoutSchemeConfig PayoutSchemeConfig     `json:"payoutSchemeConfig,omitempty"`
+	Extra              map[string]interface{} `json:"extra"`
+	FamilyExtra        PaymentProcessingExtra `json:"-"`
+}
+
+// Port is a stratum port of a pool along with its port number.
+type Port struct {
+	Number int
+	PoolEndpoint
+}
+
+// SortedPorts returns the stratum ports of the pool ordered by port number.
+// Ports whose key is not a port number are skipped.
+func (p *PoolInfo) SortedPorts() []Port {
+	res := make([]Port, 0, len(p.Ports))
+	for key, e := range p.Ports {
+		n, err := strconv.Atoi(key)
+		if err != nil || n <= 0 || n > 65535 {
+			continue
+		}
+		res = append(res, Port{Number: n, PoolEndpoint: e})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Number < res[j].Number })
+	return res
 }
 
 type PoolShareBasedBanningConfig struct {
@@ -84,45 +137,54 @@ type PoolShareBasedBanningConfig struct {
 }
 
 type PoolStats struct {
-	LastPoolBlockTime string `json:"lastPoolBlockTime"`
-	ConnectedMiners   int32  `json:"connectedMiners"`
-	PoolHashrate      int64  `json:"poolHashrate"`
-	SharesPerSecond   int32  `json:"sharesPerSecond"`
+	LastPoolBlockTime string   `json:"lastPoolBlockTime"`
+	ConnectedMiners   int32    `json:"connectedMiners"`
+	PoolHashrate      Hashrate `json:"poolHashrate"`
+	SharesPerSecond   int32    `json:"sharesPerSecond"`
 }
 
 type BlockchainStats struct {
-	NetworkType          string  `json:"networkType"`
-	NetworkHashrate      float64 `json:"networkHashrate"`
-	NetworkDifficulty    float64 `json:"networkDifficulty"`
-	NextNetworkTarget    string  `json:"nextNetworkTarget"`
-	NextNetworkBits      string  `json:"nextNetworkBits"`
-	LastNetworkBlockTime string  `json:"lastNetworkBlockTime"`
-	BlockHeight          int64   `json:"blockHeight"`
-	ConnectedPeers       int32   `json:"connectedPeers"`
-	RewardType           string  `json:"rewardType"`
+	NetworkType          string     `json:"networkType"`
+	NetworkHashrate      Hashrate   `json:"networkHashrate"`
+	NetworkDifficulty    Difficulty `json:"networkDifficulty"`
+	NextNetworkTarget    string     `json:"nextNetworkTarget"`
+	NextNetworkBits      string     `json:"nextNetworkBits"`
+	LastNetworkBlockTime string     `json:"lastNetworkBlockTime"`
+	BlockHeight          int64      `json:"blockHeight"`
+	ConnectedPeers       int32      `json:"connectedPeers"`
+	RewardType           string     `json:"rewardType"`
 }
 
 type MinerPerformanceStats struct {
-	Miner           string  `json:"miner"`
-	Hashrate        float64 `json:"hashrate"`
-	SharesPerSecond float64 `json:"sharesPerSecond"`
+	Miner           string   `json:"miner"`
+	Hashrate        Hashrate `json:"hashrate"`
+	SharesPerSecond float64  `json:"sharesPerSecond"`
 }
 
+// BlockStatus is the maturity status of a block found by a pool.
+type BlockStatus string
+
+const (
+	BlockPending   BlockStatus = "pending"
+	BlockConfirmed BlockStatus = "confirmed"
+	BlockOrphaned  BlockStatus = "orphaned"
+)
+
 type Block struct {
-	PoolID                      string  `json:"poolId"`
-	BlockHeight                 int64   `json:"blockHeight"`
-	NetworkDifficulty           float64 `json:"networkDifficulty"`
-	Status                      string  `json:"status"`
-	Type                        string  `json:"type"`
-	ConfirmationProgress        float64 `json:"confirmationProgress"`
-	Effort                      float64 `json:"effort"`
-	TransactionConfirmationData string  `json:"transactionConfirmationData"`
-	Reward                      float64 `json:"reward"`
-	InfoLink                    string  `json:"infoLink"`
-	Hash                        string  `json:"hash"`
-	Miner                       string  `json:"miner"`
-	Source                      string  `json:"source"`
-	Created                     string  `json:"created"`
+	PoolID                      string      `json:"poolId"`
+	BlockHeight                 int64       `json:"blockHeight"`
+	NetworkDifficulty           Difficulty  `json:"networkDifficulty"`
+	Status                      BlockStatus `json:"status"`
+	Type                        string      `json:"type"`
+	ConfirmationProgress        float64     `json:"confirmationProgress"`
+	Effort                      float64     `json:"effort"`
+	TransactionConfirmationData string      `json:"transactionConfirmationData"`
+	Reward                      float64     `json:"reward"`
+	InfoLink                    string      `json:"infoLink"`
+	Hash                        string      `json:"hash"`
+	Miner                       string      `json:"miner"`
+	Source                      string      `json:"source"`
+	Created                     string      `json:"created"`
 }
 
 type BlocksRes struct {
@@ -162,9 +224,9 @@ type WorkerStats struct {
 }
 
 type WorkerPerformanceStats struct {
-	Hashrate         float64 `json:"hashrate"`
-	ReportedHashrate float64 `json:"reportedHashrate"`
-	SharesPerSecond  float64 `json:"sharesPerSecond"`
+	Hashrate         Hashrate `json:"hashrate"`
+	ReportedHashrate Hashrate `json:"reportedHashrate"`
+	SharesPerSecond  float64  `json:"sharesPerSecond"`
 }
 
 type DailyEarning struct {
@@ -191,12 +253,12 @@ type BalanceChangeRes struct {
 }
 
 type PoolPerformance struct {
-	PoolHashrate         float64 `json:"poolHashrate"`
-	ConnectedMiners      int32   `json:"connectedMiners"`
-	ValidSharesPerSecond int32   `json:"validSharesPerSecond"`
-	NetworkHashrate      float64 `json:"networkHashrate"`
-	NetworkDifficulty    float64 `json:"networkDifficulty"`
-	Created              string  `json:"created"`
+	PoolHashrate         Hashrate   `json:"poolHashrate"`
+	ConnectedMiners      int32      `json:"connectedMiners"`
+	ValidSharesPerSecond int32      `json:"validSharesPerSecond"`
+	NetworkHashrate      Hashrate   `json:"networkHashrate"`
+	NetworkDifficulty    Difficulty `json:"networkDifficulty"`
+	Created              string     `json:"created"`
 }
 
 type MinerSettings struct {
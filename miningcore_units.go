@@ -0,0 +1,109 @@
+package miningcore
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var siPrefixes = []string{"", "k", "M", "G", "T", "P", "E"}
+
+// Hashrate is a hashrate in hashes (or solutions) per second.
+type Hashrate float64
+
+// String formats the hashrate using SI prefixes, e.g. "4.30 GH/s".
+func (h Hashrate) String() string {
+	return h.Format("")
+}
+
+// Format formats the hashrate using SI prefixes and the hashrate unit of the given algorithm.
+func (h Hashrate) Format(algorithm string) string {
+	v := float64(h)
+	i := 0
+	for math.Abs(v) >= 1000 && i < len(siPrefixes)-1 {
+		v /= 1000
+		i++
+	}
+	return fmt.Sprintf("%.2f %s%s", v, siPrefixes[i], HashrateUnit(algorithm))
+}
+
+// HashrateUnit returns the unit in which hashrates of the given algorithm are reported.
+// Equihash based algorithms count solutions instead of hashes.
+func HashrateUnit(algorithm string) string {
+	if strings.Contains(strings.ToLower(algorithm), "equihash") {
+		return "Sol/s"
+	}
+	return "H/s"
+}
+
+// ParseHashrate parses a hashrate like "4.3 GH/s", "4.3G" or "12 kSol/s".
+func ParseHashrate(s string) (Hashrate, error) {
+	s = strings.TrimSpace(s)
+	end := 0
+	for end < len(s) && (s[end] >= '0' && s[end] <= '9' || s[end] == '.' || s[end] == '-' || s[end] == '+') {
+		end++
+	}
+	v, err := strconv.ParseFloat(s[:end], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid hashrate %q: %w", s, err)
+	}
+
+	unit := strings.TrimSpace(s[end:])
+	lower := strings.ToLower(unit)
+	for _, suffix := range []string{"sol/s", "h/s", "sol", "h"} {
+		if strings.HasSuffix(lower, suffix) {
+			unit = unit[:len(unit)-len(suffix)]
+			break
+		}
+	}
+	if unit == "" {
+		return Hashrate(v), nil
+	}
+	for i, p := range siPrefixes[1:] {
+		if strings.EqualFold(unit, p) {
+			return Hashrate(v * math.Pow(1000, float64(i+1))), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid hashrate unit %q", s[end:])
+}
+
+// Difficulty is a share or network difficulty.
+type Difficulty float64
+
+// String formats the difficulty using SI prefixes, e.g. "12.02 P".
+func (d Difficulty) String() string {
+	v := float64(d)
+	i := 0
+	for math.Abs(v) >= 1000 && i < len(siPrefixes)-1 {
+		v /= 1000
+		i++
+	}
+	return strings.TrimSpace(fmt.Sprintf("%.2f %s", v, siPrefixes[i]))
+}
+
+// ExpectedHashes returns the number of hashes that are needed on average to find a share or block
+// of this difficulty for the given coin family.
+func (d Difficulty) ExpectedHashes(family CoinFamily) float64 {
+	switch family {
+	case FamilyBitcoin:
+		return float64(d) * (1 << 32)
+	case FamilyEquihash:
+		return float64(d) * (1 << 13)
+	default:
+		return float64(d)
+	}
+}
+
+// ExpectedTime returns the average time the given hashrate needs to find a share or block of this difficulty.
+func (d Difficulty) ExpectedTime(h Hashrate, family CoinFamily) time.Duration {
+	if h <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+	secs := d.ExpectedHashes(family) / float64(h)
+	if secs >= float64(math.MaxInt64)/float64(time.Second) {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(secs * float64(time.Second))
+}
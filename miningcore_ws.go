@@ -1,15 +1,26 @@
 package miningcore
 
+import (
+	"encoding/json"
+	"errors"
+)
+
 type WebsocketMsg string
 
 const (
 	WsBlockFound            WebsocketMsg = "blockfound"
 	WsNewChainHeight        WebsocketMsg = "newchainheight"
 	WsPayment               WebsocketMsg = "payment"
+	WsBlockUnlocked         WebsocketMsg = "blockunlocked"
 	WsBlockUnlockedProgress WebsocketMsg = "blockunlockedprogress"
 	WsHashrateUpdated       WebsocketMsg = "hashrateupdated"
 )
 
+// Notification is implemented by all typed messages of the notification stream.
+type Notification interface {
+	MessageType() WebsocketMsg
+}
+
 type RawMessage struct {
 	Type string `json:"type"`
 }
@@ -43,6 +54,20 @@ type PaymentMessage struct {
 	Error           error    `json:"error"`
 }
 
+// MarshalJSON encodes the error of the payment as its message, like the notification stream sends it.
+func (m PaymentMessage) MarshalJSON() ([]byte, error) {
+	type message PaymentMessage
+	var errText *string
+	if m.Error != nil {
+		text := m.Error.Error()
+		errText = &text
+	}
+	return json.Marshal(struct {
+		message
+		Error *string `json:"error"`
+	}{message(m), errText})
+}
+
 type BlockUnlockedMessage struct {
 	BlockMessage
 	BlockType         string  `json:"blockType"`
@@ -61,8 +86,98 @@ type BlockUnlockProgressMessage struct {
 }
 
 type HashRateUpdateMessage struct {
-	PoolID   string  `json:"poolId"`
-	Hashrate float64 `json:"hashrate"`
-	Miner    string  `json:"miner"`
-	Worker   string  `json:"worker"`
+	PoolID   string   `json:"poolId"`
+	Hashrate Hashrate `json:"hashrate"`
+	Miner    string   `json:"miner"`
+	Worker   string   `json:"worker"`
+}
+
+// MessageType implements Notification.
+func (BlockFoundMessage) MessageType() WebsocketMsg { return WsBlockFound }
+
+// MessageType implements Notification.
+func (ChainHeightMessage) MessageType() WebsocketMsg { return WsNewChainHeight }
+
+// MessageType implements Notification.
+func (PaymentMessage) MessageType() WebsocketMsg { return WsPayment }
+
+// MessageType implements Notification.
+func (BlockUnlockedMessage) MessageType() WebsocketMsg { return WsBlockUnlocked }
+
+// MessageType implements Notification.
+func (BlockUnlockProgressMessage) MessageType() WebsocketMsg { return WsBlockUnlockedProgress }
+
+// MessageType implements Notification.
+func (HashRateUpdateMessage) MessageType() WebsocketMsg { return WsHashrateUpdated }
+
+// PoolID returns the pool id of a notification. Messages are accepted as values and as pointers.
+func PoolID(n Notification) string {
+	switch m := n.(type) {
+	case *BlockFoundMessage:
+		return m.PoolID
+	case BlockFoundMessage:
+		return m.PoolID
+	case *ChainHeightMessage:
+		return m.PoolID
+	case ChainHeightMessage:
+		return m.PoolID
+	case *BlockUnlockedMessage:
+		return m.PoolID
+	case BlockUnlockedMessage:
+		return m.PoolID
+	case *BlockUnlockProgressMessage:
+		return m.PoolID
+	case BlockUnlockProgressMessage:
+		return m.PoolID
+	case *PaymentMessage:
+		return m.PoolID
+	case PaymentMessage:
+		return m.PoolID
+	case *HashRateUpdateMessage:
+		return m.PoolID
+	case HashRateUpdateMessage:
+		return m.PoolID
+	}
+	return ""
+}
+
+// DecodeNotification decodes a message of the notification stream into its typed message.
+// Unknown message types return nil without an error.
+func DecodeNotification(data []byte) (Notification, error) {
+	var raw RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	var n Notification
+	switch WebsocketMsg(raw.Type) {
+	case WsBlockFound:
+		n = &BlockFoundMessage{}
+	case WsNewChainHeight:
+		n = &ChainHeightMessage{}
+	case WsBlockUnlocked:
+		n = &BlockUnlockedMessage{}
+	case WsBlockUnlockedProgress:
+		n = &BlockUnlockProgressMessage{}
+	case WsHashrateUpdated:
+		n = &HashRateUpdateMessage{}
+	case WsPayment:
+		// the error of a payment is sent as a string
+		var p struct {
+			PaymentMessage
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(data, &p); err != nil {
+			return nil, err
+		}
+		if p.Error != "" {
+			p.PaymentMessage.Error = errors.New(p.Error)
+		}
+		return &p.PaymentMessage, nil
+	default:
+		return nil, nil
+	}
+	if err := json.Unmarshal(data, n); err != nil {
+		return nil, err
+	}
+	return n, nil
 }
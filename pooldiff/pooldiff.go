@@ -0,0 +1,256 @@
+// Package pooldiff compares the configuration of pools between two snapshots, e.g. to audit
+// fee, payment, port, vardiff and banning changes.
+package pooldiff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Kind is the kind of a change.
+type Kind string
+
+const (
+	Added    Kind = "added"
+	Removed  Kind = "removed"
+	Modified Kind = "modified"
+)
+
+// Change is a single config difference. Path is the dotted json path of the value within
+// the pool, e.g. paymentProcessing.minimumPayment or ports.4073.varDiff.minDiff, and empty
+// for pools that were added or removed.
+type Change struct {
+	Pool string `json:"pool"`
+	Path string `json:"path"`
+	Kind Kind   `json:"kind"`
+	Old  any    `json:"old,omitempty"`
+	New  any    `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	if c.Path == "" {
+		return fmt.Sprintf("pool %s %s", c.Pool, c.Kind)
+	}
+	name := c.Pool + " " + c.Path
+	switch c.Kind {
+	case Added:
+		return fmt.Sprintf("%s added: %s", name, format(c.New))
+	case Removed:
+		return fmt.Sprintf("%s removed: %s", name, format(c.Old))
+	}
+	return fmt.Sprintf("%s changed from %s to %s", name, format(c.Old), format(c.New))
+}
+
+// Diff is the list of changes between two snapshots, ordered by pool and path.
+type Diff []Change
+
+// Filter returns the changes of the given paths and everything below them.
+func (d Diff) Filter(paths ...string) Diff {
+	var res Diff
+	for _, c := range d {
+		for _, p := range paths {
+			if c.Path == p || strings.HasPrefix(c.Path, p+".") {
+				res = append(res, c)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// WriteText writes one line per change.
+func (d Diff) WriteText(w io.Writer) error {
+	for _, c := range d {
+		if _, err := fmt.Fprintln(w, c.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// statsFields are the PoolInfo fields that change during operation and are not config.
+var statsFields = []string{
+	"poolStats", "networkStats", "topMiners", "totalPaid", "totalBlocks",
+	"totalConfirmedBlocks", "lastPoolBlockTime", "poolEffort",
+}
+
+// Compare returns the config changes of a pool between two snapshots.
+func Compare(old, new *miningcore.PoolInfo) (Diff, error) {
+	a, err := config(old)
+	if err != nil {
+		return nil, err
+	}
+	b, err := config(new)
+	if err != nil {
+		return nil, err
+	}
+	id := new.ID
+	if id == "" {
+		id = old.ID
+	}
+	var d Diff
+	compare(&d, id, "", a, b)
+	sort.SliceStable(d, func(i, j int) bool { return d[i].Path < d[j].Path })
+	return d, nil
+}
+
+// ComparePools returns the config changes between two GetPools results, matching the pools by id.
+func ComparePools(old, new []*miningcore.PoolInfo) (Diff, error) {
+	before := make(map[string]*miningcore.PoolInfo, len(old))
+	for _, p := range old {
+		before[p.ID] = p
+	}
+	after := make(map[string]*miningcore.PoolInfo, len(new))
+	for _, p := range new {
+		after[p.ID] = p
+	}
+	ids := make([]string, 0, len(before)+len(after))
+	for id := range before {
+		ids = append(ids, id)
+	}
+	for id := range after {
+		if _, ok := before[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	var d Diff
+	for _, id := range ids {
+		a, b := before[id], after[id]
+		switch {
+		case a == nil:
+			d = append(d, Change{Pool: id, Kind: Added})
+		case b == nil:
+			d = append(d, Change{Pool: id, Kind: Removed})
+		default:
+			changes, err := Compare(a, b)
+			if err != nil {
+				return nil, err
+			}
+			d = append(d, changes...)
+		}
+	}
+	return d, nil
+}
+
+// LoadSnapshot reads pools saved as a single PoolInfo, a list of pools or a raw
+// /api/pools response.
+func LoadSnapshot(r io.Reader) ([]*miningcore.PoolInfo, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	b = bytes.TrimSpace(b)
+	if len(b) > 0 && b[0] == '[' {
+		var pools []*miningcore.PoolInfo
+		err := json.Unmarshal(b, &pools)
+		return pools, err
+	}
+	var res struct {
+		Pools []*miningcore.PoolInfo `json:"pools"`
+		Pool  *miningcore.PoolInfo   `json:"pool"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, err
+	}
+	switch {
+	case res.Pools != nil:
+		return res.Pools, nil
+	case res.Pool != nil:
+		return []*miningcore.PoolInfo{res.Pool}, nil
+	}
+	var pool miningcore.PoolInfo
+	if err := json.Unmarshal(b, &pool); err != nil {
+		return nil, err
+	}
+	return []*miningcore.PoolInfo{&pool}, nil
+}
+
+// config returns the generic json representation of the config fields of a pool.
+func config(p *miningcore.PoolInfo) (map[string]any, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for _, f := range statsFields {
+		delete(m, f)
+	}
+	return m, nil
+}
+
+// compare walks both values and records the differences, objects are compared key by key
+// and all other values as a whole.
+func compare(d *Diff, pool, path string, a, b any) {
+	am, aok := a.(map[string]any)
+	bm, bok := b.(map[string]any)
+	if aok && bok {
+		keys := make(map[string]struct{}, len(am)+len(bm))
+		for k := range am {
+			keys[k] = struct{}{}
+		}
+		for k := range bm {
+			keys[k] = struct{}{}
+		}
+		for k := range keys {
+			compare(d, pool, join(path, k), am[k], bm[k])
+		}
+		return
+	}
+	if reflect.DeepEqual(a, b) {
+		return
+	}
+	c := Change{Pool: pool, Path: path, Kind: Modified, Old: redact(path, a), New: redact(path, b)}
+	switch {
+	case a == nil:
+		c.Kind = Added
+	case b == nil:
+		c.Kind = Removed
+	}
+	*d = append(*d, c)
+}
+
+func join(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// redact hides the values of secrets like the tls certificate password, also within objects.
+func redact(path string, v any) any {
+	if v != nil && strings.Contains(strings.ToLower(path), "password") {
+		return "***"
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+	res := make(map[string]any, len(m))
+	for k, val := range m {
+		res[k] = redact(k, val)
+	}
+	return res
+}
+
+func format(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,75 @@
+package pooldiff
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func loadPools(t *testing.T) []*miningcore.PoolInfo {
+	f, err := os.Open("../testdata/pools.json")
+	assert.NoError(t, err)
+	defer f.Close()
+	pools, err := LoadSnapshot(f)
+	assert.NoError(t, err)
+	return pools
+}
+
+func TestCompare(t *testing.T) {
+	old := loadPools(t)
+	assert.Len(t, old, 1)
+	new := loadPools(t)
+	p := new[0]
+
+	d, err := Compare(old[0], p)
+	assert.NoError(t, err)
+	assert.Empty(t, d)
+
+	p.PoolFeePercent = 2
+	p.PaymentProcessing.MinimumPayment = 0.5
+	p.PoolStats = &miningcore.PoolStats{ConnectedMiners: 1000}
+	delete(p.Ports, "422")
+	p.Ports["5000"] = miningcore.PoolEndpoint{ListenAddress: "*", Difficulty: 8, TLSPfxPassword: "secret"}
+	port := p.Ports["420"]
+	port.VarDiff = &miningcore.VarDiffConfig{MinDiff: 4, TargetTime: 15, RetargetTime: 90, VariancePercent: 30}
+	p.Ports["420"] = port
+	p.ShareBasedBanning = &miningcore.PoolShareBasedBanningConfig{Enabeld: true, InvalidPercent: 50}
+
+	d, err = Compare(old[0], p)
+	assert.NoError(t, err)
+	paths := make(map[string]Kind)
+	for _, c := range d {
+		assert.Equal(t, "eth", c.Pool)
+		paths[c.Path] = c.Kind
+	}
+	assert.Equal(t, map[string]Kind{
+		"paymentProcessing.minimumPayment": Modified,
+		"poolFeePercent":                   Modified,
+		"ports.420.varDiff.minDiff":        Modified,
+		"ports.422":                        Removed,
+		"ports.5000":                       Added,
+		"shareBasedBanning":                Added,
+	}, paths)
+
+	fees := d.Filter("poolFeePercent", "paymentProcessing")
+	assert.Len(t, fees, 2)
+	var buf bytes.Buffer
+	assert.NoError(t, fees.WriteText(&buf))
+	assert.Equal(t, "eth paymentProcessing.minimumPayment changed from 0.1 to 0.5\neth poolFeePercent changed from 1 to 2\n", buf.String())
+
+	// secrets are never part of the diff
+	added := d.Filter("ports.5000")[0].New.(map[string]any)
+	assert.Equal(t, "***", added["tlsPfxPassword"])
+}
+
+func TestComparePools(t *testing.T) {
+	old := loadPools(t)
+	btc := &miningcore.PoolInfo{ID: "btc", PoolFeePercent: 1}
+	d, err := ComparePools(old, []*miningcore.PoolInfo{btc})
+	assert.NoError(t, err)
+	assert.Equal(t, Diff{{Pool: "btc", Kind: Added}, {Pool: "eth", Kind: Removed}}, d)
+	assert.Equal(t, "pool btc added", d[0].String())
+}
@@ -0,0 +1,298 @@
+// Package probe connects to the stratum ports a pool advertises, inspects their TLS
+// certificates and checks that the stratum server answers a subscribe request.
+package probe
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Cert describes the leaf certificate presented by a TLS port.
+// VerifyError is set if the certificate chain is not trusted, which is expected for TLSAuto
+// ports that use a self-signed certificate.
+type Cert struct {
+	Subject          string    `json:"subject"`
+	Issuer           string    `json:"issuer"`
+	DNSNames         []string  `json:"dnsNames"`
+	NotAfter         time.Time `json:"notAfter"`
+	ExpiresIn        Duration  `json:"expiresIn"`
+	Expired          bool      `json:"expired"`
+	HostnameMismatch bool      `json:"hostnameMismatch"`
+	VerifyError      string    `json:"verifyError,omitempty"`
+}
+
+// Duration is a time.Duration that is marshalled as a string like 72h0m0s.
+type Duration time.Duration
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(d).String()), nil
+}
+
+// Result is the outcome of probing a single port.
+// Subscribed is true if the server answered the subscribe request, even with an error,
+// SubscribeError holds the error the server returned.
+type Result struct {
+	Pool           string   `json:"pool"`
+	Port           string   `json:"port"`
+	Name           string   `json:"name"`
+	Address        string   `json:"address"`
+	TLS            bool     `json:"tls"`
+	Reachable      bool     `json:"reachable"`
+	Latency        Duration `json:"latency"`
+	Cert           *Cert    `json:"cert,omitempty"`
+	Subscribed     bool     `json:"subscribed"`
+	SubscribeError string   `json:"subscribeError,omitempty"`
+	Warnings       []string `json:"warnings,omitempty"`
+	Error          string   `json:"error,omitempty"`
+}
+
+// OK reports whether the port is reachable, answers stratum requests and has no expired certificate.
+func (r *Result) OK() bool {
+	return r.Reachable && r.Subscribed && (r.Cert == nil || !r.Cert.Expired)
+}
+
+// ProberOpts are options for the prober.
+type ProberOpts func(*Prober)
+
+// WithHost sets the host the ports are dialed on. By default the listen address of a port is
+// used, or localhost if it listens on all interfaces.
+func WithHost(host string) ProberOpts {
+	return func(p *Prober) {
+		p.host = host
+	}
+}
+
+// WithServerName sets the name TLS certificates are checked against, by default the dialed host.
+func WithServerName(name string) ProberOpts {
+	return func(p *Prober) {
+		p.serverName = name
+	}
+}
+
+// WithRootCAs sets the certificate pool used to verify TLS certificates, by default the system pool.
+func WithRootCAs(pool *x509.CertPool) ProberOpts {
+	return func(p *Prober) {
+		p.roots = pool
+	}
+}
+
+// WithTimeout sets the timeout for probing a single port.
+func WithTimeout(d time.Duration) ProberOpts {
+	return func(p *Prober) {
+		p.timeout = d
+	}
+}
+
+// WithExpiryWarning sets how long before its expiry a certificate is reported in the warnings.
+func WithExpiryWarning(d time.Duration) ProberOpts {
+	return func(p *Prober) {
+		p.expiryWarning = d
+	}
+}
+
+// WithUserAgent sets the user agent sent with the subscribe request.
+func WithUserAgent(ua string) ProberOpts {
+	return func(p *Prober) {
+		p.userAgent = ua
+	}
+}
+
+// Prober probes stratum ports.
+type Prober struct {
+	host          string
+	serverName    string
+	roots         *x509.CertPool
+	timeout       time.Duration
+	expiryWarning time.Duration
+	userAgent     string
+	now           func() time.Time
+}
+
+// New creates a new prober.
+func New(opts ...ProberOpts) *Prober {
+	p := &Prober{
+		timeout:       10 * time.Second,
+		expiryWarning: 14 * 24 * time.Hour,
+		userAgent:     "go-miningcore-probe/1.0",
+		now:           time.Now,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// ProbePool probes all ports of a pool, ordered by port number.
+// Ports whose key is not a port number are skipped.
+func (p *Prober) ProbePool(ctx context.Context, pool *miningcore.PoolInfo) []*Result {
+	ports := pool.SortedPorts()
+	res := make([]*Result, 0, len(ports))
+	for _, port := range ports {
+		r := p.Probe(ctx, strconv.Itoa(port.Number), port.PoolEndpoint)
+		r.Pool = pool.ID
+		res = append(res, r)
+	}
+	return res
+}
+
+// Probe connects to a single port, inspects its certificate if it uses TLS and sends a
+// mining.subscribe request.
+func (p *Prober) Probe(ctx context.Context, port string, e miningcore.PoolEndpoint) *Result {
+	host := p.hostOf(e)
+	r := &Result{
+		Port:    port,
+		Name:    e.Name,
+		Address: net.JoinHostPort(host, port),
+		TLS:     e.TLS,
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	start := p.now()
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", r.Address)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	defer conn.Close()
+	r.Reachable = true
+	r.Latency = Duration(p.now().Sub(start))
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			r.Error = fmt.Sprintf("set deadline: %v", err)
+			return r
+		}
+	}
+
+	if e.TLS {
+		serverName := p.serverName
+		if serverName == "" {
+			serverName = host
+		}
+		// the certificate is verified separately to report problems instead of failing the handshake
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: serverName, InsecureSkipVerify: true})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			r.Error = fmt.Sprintf("tls handshake: %v", err)
+			return r
+		}
+		r.Cert = p.inspect(tlsConn.ConnectionState().PeerCertificates, serverName)
+		r.Warnings = append(r.Warnings, p.certWarnings(r.Cert)...)
+		conn = tlsConn
+	}
+
+	if err := p.subscribe(conn, r); err != nil {
+		r.Error = err.Error()
+	}
+	return r
+}
+
+func (p *Prober) hostOf(e miningcore.PoolEndpoint) string {
+	if p.host != "" {
+		return p.host
+	}
+	return e.DialHost()
+}
+
+func (p *Prober) inspect(chain []*x509.Certificate, serverName string) *Cert {
+	if len(chain) == 0 {
+		return nil
+	}
+	leaf := chain[0]
+	now := p.now()
+	c := &Cert{
+		Subject:   leaf.Subject.String(),
+		Issuer:    leaf.Issuer.String(),
+		DNSNames:  leaf.DNSNames,
+		NotAfter:  leaf.NotAfter,
+		ExpiresIn: Duration(leaf.NotAfter.Sub(now).Round(time.Second)),
+		Expired:   now.After(leaf.NotAfter),
+	}
+	c.HostnameMismatch = leaf.VerifyHostname(serverName) != nil
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         p.roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+	})
+	if err != nil {
+		c.VerifyError = err.Error()
+	}
+	return c
+}
+
+func (p *Prober) certWarnings(c *Cert) []string {
+	if c == nil {
+		return []string{"no certificate presented"}
+	}
+	var w []string
+	switch {
+	case c.Expired:
+		w = append(w, fmt.Sprintf("certificate expired on %s", c.NotAfter.Format(time.RFC3339)))
+	case time.Duration(c.ExpiresIn) < p.expiryWarning:
+		w = append(w, fmt.Sprintf("certificate expires in %s", time.Duration(c.ExpiresIn)))
+	}
+	if c.HostnameMismatch {
+		w = append(w, fmt.Sprintf("certificate is not valid for the host, it covers %s", strings.Join(c.DNSNames, ", ")))
+	}
+	if c.VerifyError != "" {
+		w = append(w, "certificate is not trusted: "+c.VerifyError)
+	}
+	return w
+}
+
+type rpcResponse struct {
+	ID     json.RawMessage `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  json.RawMessage `json:"error"`
+}
+
+// subscribe sends mining.subscribe and waits for the response to it, notifications the
+// server sends in the meantime are skipped.
+func (p *Prober) subscribe(conn net.Conn, r *Result) error {
+	req, err := json.Marshal(map[string]any{
+		"id":     1,
+		"method": "mining.subscribe",
+		"params": []string{p.userAgent},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := conn.Write(append(req, '\n')); err != nil {
+		return fmt.Errorf("send subscribe: %w", err)
+	}
+	sc := bufio.NewScanner(conn)
+	for sc.Scan() {
+		var res rpcResponse
+		if err := json.Unmarshal(sc.Bytes(), &res); err != nil {
+			return fmt.Errorf("invalid stratum response: %w", err)
+		}
+		if id, err := strconv.Atoi(string(res.ID)); err != nil || id != 1 {
+			continue
+		}
+		r.Subscribed = true
+		if len(res.Error) > 0 && string(res.Error) != "null" {
+			r.SubscribeError = string(res.Error)
+		}
+		return nil
+	}
+	if err := sc.Err(); err != nil {
+		return fmt.Errorf("read subscribe response: %w", err)
+	}
+	return errors.New("connection closed before subscribe response")
+}
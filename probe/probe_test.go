@@ -0,0 +1,160 @@
+package probe
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeStratum answers mining.subscribe after sending an unrelated notification first.
+func fakeStratum(ln net.Listener) string {
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				sc := bufio.NewScanner(conn)
+				for sc.Scan() {
+					var req struct {
+						ID     int    `json:"id"`
+						Method string `json:"method"`
+					}
+					if json.Unmarshal(sc.Bytes(), &req) != nil {
+						return
+					}
+					conn.Write([]byte(`{"id":null,"method":"mining.set_difficulty","params":[1]}` + "\n"))
+					if req.Method == "mining.subscribe" {
+						conn.Write([]byte(`{"id":` + strconv.Itoa(req.ID) + `,"result":[[["mining.notify","1"]],"08000002",4],"error":null}` + "\n"))
+					}
+				}
+			}(conn)
+		}
+	}()
+	return strconv.Itoa(ln.Addr().(*net.TCPAddr).Port)
+}
+
+func selfSigned(t *testing.T, name string, notAfter time.Time) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		DNSNames:              []string{name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func TestProbe(t *testing.T) {
+	plain, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer plain.Close()
+	plainPort := fakeStratum(plain)
+
+	cert := selfSigned(t, "pool.example", time.Now().Add(72*time.Hour))
+	secure, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{cert}})
+	assert.NoError(t, err)
+	defer secure.Close()
+	tlsPort := fakeStratum(secure)
+
+	closed, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	closedPort := strconv.Itoa(closed.Addr().(*net.TCPAddr).Port)
+	closed.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(cert.Leaf)
+	pool := &miningcore.PoolInfo{
+		ID: "btc",
+		Ports: map[string]miningcore.PoolEndpoint{
+			plainPort:  {Name: "plain", ListenAddress: "127.0.0.1"},
+			tlsPort:    {Name: "tls", ListenAddress: "0.0.0.0", TLS: true},
+			closedPort: {Name: "closed", ListenAddress: "127.0.0.1"},
+		},
+	}
+	p := New(WithHost("127.0.0.1"), WithServerName("pool.example"), WithRootCAs(roots), WithTimeout(2*time.Second))
+	byName := make(map[string]*Result)
+	for _, r := range p.ProbePool(context.Background(), pool) {
+		assert.Equal(t, "btc", r.Pool)
+		byName[r.Name] = r
+	}
+
+	assert.True(t, byName["plain"].OK())
+	assert.Nil(t, byName["plain"].Cert)
+	assert.Empty(t, byName["plain"].Warnings)
+
+	r := byName["tls"]
+	assert.True(t, r.OK(), r.Error)
+	assert.False(t, r.Cert.HostnameMismatch)
+	assert.Empty(t, r.Cert.VerifyError)
+	assert.InDelta(t, 72*time.Hour, time.Duration(r.Cert.ExpiresIn), float64(time.Minute))
+	assert.Len(t, r.Warnings, 1)
+	assert.Contains(t, r.Warnings[0], "certificate expires in")
+
+	assert.False(t, byName["closed"].OK())
+	assert.False(t, byName["closed"].Reachable)
+	assert.NotEmpty(t, byName["closed"].Error)
+
+	// dialing by ip without a server name can't match the certificate and isn't trusted
+	r = New(WithHost("127.0.0.1"), WithExpiryWarning(time.Hour)).Probe(context.Background(), tlsPort, pool.Ports[tlsPort])
+	assert.True(t, r.OK())
+	assert.True(t, r.Cert.HostnameMismatch)
+	assert.NotEmpty(t, r.Cert.VerifyError)
+	assert.Len(t, r.Warnings, 2)
+}
+
+func TestProbeLatency(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer ln.Close()
+	port := fakeStratum(ln)
+
+	p := New(WithHost("127.0.0.1"))
+	now := time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)
+	p.now = func() time.Time {
+		now = now.Add(5 * time.Millisecond)
+		return now
+	}
+	r := p.Probe(context.Background(), port, miningcore.PoolEndpoint{})
+	assert.True(t, r.OK(), r.Error)
+	assert.Equal(t, Duration(5*time.Millisecond), r.Latency)
+}
+
+func TestProbePoolOrder(t *testing.T) {
+	pool := &miningcore.PoolInfo{Ports: map[string]miningcore.PoolEndpoint{
+		"10000":   {Name: "high"},
+		"3032":    {Name: "low"},
+		"stratum": {Name: "invalid"},
+	}}
+	var names []string
+	for _, r := range New(WithHost("127.0.0.1"), WithTimeout(time.Second)).ProbePool(context.Background(), pool) {
+		names = append(names, r.Name)
+	}
+	assert.Equal(t, []string{"low", "high"}, names)
+}
@@ -0,0 +1,353 @@
+// Package proxy serves the miningcore /api routes from an upstream instance through the
+// client, with response caching, per client rate limiting, CORS and address filtering.
+// Only the read endpoints are served, updating miner settings is not proxied.
+package proxy
+
+import (
+	"container/list"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// ServerOpts are options for the server.
+type ServerOpts func(*Server)
+
+// WithCacheTTL sets how long responses are cached, zero disables the cache.
+func WithCacheTTL(d time.Duration) ServerOpts {
+	return func(s *Server) {
+		s.cacheTTL = d
+	}
+}
+
+// WithCacheSize sets the maximum number of cached responses, the least recently used ones are evicted first.
+func WithCacheSize(n int) ServerOpts {
+	return func(s *Server) {
+		s.cacheSize = n
+	}
+}
+
+// WithRateLimit limits every client ip to rps requests per second with bursts of up to burst requests.
+func WithRateLimit(rps float64, burst int) ServerOpts {
+	return func(s *Server) {
+		s.limiter = newLimiter(rps, burst)
+	}
+}
+
+// WithForwardedFor takes the client ip from the X-Forwarded-For header when the server runs
+// behind the given number of trusted reverse proxies. Each proxy appends the address it was
+// connected from, so the client ip is the entry that many hops from the right. Entries further
+// left are set by the client and are ignored.
+func WithForwardedFor(hops int) ServerOpts {
+	return func(s *Server) {
+		s.trustedHops = hops
+	}
+}
+
+// WithCORS sets the origins allowed to access the api from browsers, * allows all origins.
+func WithCORS(origins ...string) ServerOpts {
+	return func(s *Server) {
+		s.origins = origins
+	}
+}
+
+// WithAllowedAddresses only serves the miner endpoints for the given addresses.
+func WithAllowedAddresses(addrs ...string) ServerOpts {
+	return func(s *Server) {
+		s.allowed = toSet(s.allowed, addrs)
+	}
+}
+
+// WithBlockedAddresses refuses the miner endpoints for the given addresses.
+func WithBlockedAddresses(addrs ...string) ServerOpts {
+	return func(s *Server) {
+		s.blocked = toSet(s.blocked, addrs)
+	}
+}
+
+// WithErrorHandler sets a function that is called with errors of failed upstream requests.
+func WithErrorHandler(fn func(error)) ServerOpts {
+	return func(s *Server) {
+		s.onError = fn
+	}
+}
+
+// Server is an http.Handler that serves the miningcore api.
+type Server struct {
+	client      *miningcore.Client
+	cacheTTL    time.Duration
+	cacheSize   int
+	limiter     *limiter
+	trustedHops int
+	origins     []string
+	allowed     map[string]bool
+	blocked     map[string]bool
+	onError     func(error)
+	now         func() time.Time
+
+	mu       sync.Mutex
+	cache    map[string]*list.Element
+	lru      *list.List
+	inflight map[string]*call
+}
+
+type entry struct {
+	key     string
+	status  int
+	body    []byte
+	expires time.Time
+}
+
+type call struct {
+	done chan struct{}
+	res  *entry
+}
+
+// New creates a new server that fetches from the given client.
+func New(c *miningcore.Client, opts ...ServerOpts) *Server {
+	s := &Server{
+		client:    c,
+		cacheTTL:  10 * time.Second,
+		cacheSize: 10000,
+		now:       time.Now,
+		cache:     make(map[string]*list.Element),
+		lru:       list.New(),
+		inflight:  make(map[string]*call),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.cors(w, r)
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	if s.limiter != nil && !s.limiter.allow(s.clientIP(r), s.now()) {
+		w.Header().Set("Retry-After", "1")
+		writeError(w, http.StatusTooManyRequests, "rate limit exceeded")
+		return
+	}
+
+	rt, ok := match(r.URL.EscapedPath())
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	if rt.addr != "" && !s.addressAllowed(rt.addr) {
+		writeError(w, http.StatusForbidden, "address not allowed")
+		return
+	}
+
+	// only the parameters of the route are passed on and make up the cache key
+	p, query := params(r, rt.params)
+	key := strings.TrimSuffix(r.URL.EscapedPath(), "/") + "?" + query
+	res := s.fetch(r.Context(), key, func(ctx context.Context) (int, json.RawMessage, error) {
+		var body json.RawMessage
+		status, err := rt.fetch(ctx, s.client, rt.id, rt.addr, p, &body)
+		if err != nil || !rt.unwrap {
+			return status, body, err
+		}
+		var paged struct {
+			Result json.RawMessage `json:"result"`
+		}
+		if err := json.Unmarshal(body, &paged); err != nil {
+			return 0, nil, err
+		}
+		return status, paged.Result, nil
+	})
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(res.status)
+	w.Write(res.body)
+}
+
+// fetch returns the cached response for key or calls fn, concurrent requests for the same key share one call.
+func (s *Server) fetch(ctx context.Context, key string, fn func(context.Context) (int, json.RawMessage, error)) *entry {
+	now := s.now()
+	s.mu.Lock()
+	if e := s.cached(key, now); e != nil {
+		s.mu.Unlock()
+		return e
+	}
+	if c, ok := s.inflight[key]; ok {
+		s.mu.Unlock()
+		select {
+		case <-c.done:
+			return c.res
+		case <-ctx.Done():
+			return &entry{status: http.StatusGatewayTimeout, body: errorBody("request canceled")}
+		}
+	}
+	c := &call{done: make(chan struct{})}
+	s.inflight[key] = c
+	s.mu.Unlock()
+
+	// the upstream request is detached from the client, other waiters may still need the result
+	status, body, err := fn(context.Background())
+	res := &entry{key: key, status: status, body: body}
+	if err != nil {
+		res = s.errorEntry(key, status, err)
+	}
+
+	s.mu.Lock()
+	delete(s.inflight, key)
+	if s.cacheTTL > 0 && res.status < http.StatusInternalServerError {
+		res.expires = s.now().Add(s.cacheTTL)
+		s.store(key, res)
+	}
+	s.mu.Unlock()
+	c.res = res
+	close(c.done)
+	return res
+}
+
+func (s *Server) errorEntry(key string, status int, err error) *entry {
+	if s.onError != nil {
+		s.onError(err)
+	}
+	switch {
+	case errors.Is(err, miningcore.ErrInvalidAddress):
+		return &entry{key: key, status: http.StatusBadRequest, body: errorBody(err.Error())}
+	case status == 0:
+		return &entry{key: key, status: http.StatusBadGateway, body: errorBody("upstream unavailable")}
+	}
+	// miningcore returns its error as the response body
+	body := []byte(err.Error())
+	if !json.Valid(body) {
+		body = errorBody(err.Error())
+	}
+	return &entry{key: key, status: status, body: body}
+}
+
+// cached returns the entry for key if it hasn't expired and marks it as recently used.
+// The caller must hold the lock.
+func (s *Server) cached(key string, now time.Time) *entry {
+	el, ok := s.cache[key]
+	if !ok {
+		return nil
+	}
+	e := el.Value.(*entry)
+	if !now.Before(e.expires) {
+		s.lru.Remove(el)
+		delete(s.cache, key)
+		return nil
+	}
+	s.lru.MoveToFront(el)
+	return e
+}
+
+// store adds an entry and evicts the least recently used entries once the cache is full.
+// The caller must hold the lock.
+func (s *Server) store(key string, e *entry) {
+	if el, ok := s.cache[key]; ok {
+		el.Value = e
+		s.lru.MoveToFront(el)
+		return
+	}
+	s.cache[key] = s.lru.PushFront(e)
+	for s.lru.Len() > s.cacheSize {
+		oldest := s.lru.Back()
+		s.lru.Remove(oldest)
+		delete(s.cache, oldest.Value.(*entry).key)
+	}
+}
+
+// cors sets the CORS headers for allowed origins. With CORS configured every response varies
+// by Origin, so caches don't hand a response without the headers to an allowed origin.
+func (s *Server) cors(w http.ResponseWriter, r *http.Request) {
+	if len(s.origins) == 0 {
+		return
+	}
+	h := w.Header()
+	h.Add("Vary", "Origin")
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return
+	}
+	for _, o := range s.origins {
+		if o == "*" || strings.EqualFold(o, origin) {
+			h.Set("Access-Control-Allow-Origin", origin)
+			h.Set("Access-Control-Allow-Methods", "GET, HEAD, OPTIONS")
+			h.Set("Access-Control-Allow-Headers", "Content-Type")
+			h.Set("Access-Control-Max-Age", "86400")
+			return
+		}
+	}
+}
+
+func (s *Server) clientIP(r *http.Request) string {
+	if s.trustedHops > 0 {
+		var hops []string
+		for _, v := range r.Header.Values("X-Forwarded-For") {
+			hops = append(hops, strings.Split(v, ",")...)
+		}
+		if len(hops) >= s.trustedHops {
+			if ip := strings.TrimSpace(hops[len(hops)-s.trustedHops]); ip != "" {
+				return ip
+			}
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func (s *Server) addressAllowed(addr string) bool {
+	key := strings.ToLower(addr)
+	if s.blocked[key] {
+		return false
+	}
+	return len(s.allowed) == 0 || s.allowed[key]
+}
+
+func toSet(m map[string]bool, values []string) map[string]bool {
+	if m == nil {
+		m = make(map[string]bool)
+	}
+	for _, v := range values {
+		m[strings.ToLower(v)] = true
+	}
+	return m
+}
+
+// params returns the first value of the allowed query parameters and their encoded query.
+func params(r *http.Request, allowed []string) (map[string]string, string) {
+	p := make(map[string]string)
+	q := make(url.Values)
+	for _, name := range allowed {
+		if v := r.URL.Query().Get(name); v != "" {
+			p[name] = v
+			q.Set(name, v)
+		}
+	}
+	return p, q.Encode()
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(errorBody(msg))
+}
+
+func errorBody(msg string) []byte {
+	b, _ := json.Marshal(map[string]string{"message": msg})
+	return b
+}
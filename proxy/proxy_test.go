@@ -0,0 +1,157 @@
+package proxy
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProxy(t *testing.T) {
+	var hits int32
+	var lastQuery string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		lastQuery = r.URL.RawQuery
+		switch r.URL.Path {
+		case "/api/pools":
+			w.Write([]byte(`{"pools":[{"id":"eth","custom":true}],"meta":null}`))
+		case "/api/v2/pools/eth/miners/0xabc/earnings/daily":
+			w.Write([]byte(`{"result":[{"amount":1,"date":"2022-07-01T00:00:00Z"}],"meta":{"pageCount":1}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message":"pool not found"}`))
+		}
+	}))
+	defer upstream.Close()
+
+	now := time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)
+	s := New(miningcore.New(upstream.URL),
+		WithCacheTTL(time.Minute),
+		WithCORS("https://pool.example"),
+		WithBlockedAddresses("0xBAD"),
+	)
+	s.now = func() time.Time { return now }
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Origin", "https://pool.example")
+		s.ServeHTTP(rec, req)
+		return rec
+	}
+	body := func(rec *httptest.ResponseRecorder) string {
+		b, _ := io.ReadAll(rec.Body)
+		return string(b)
+	}
+
+	// responses are passed through unchanged, including fields the client doesn't know
+	rec := get("/api/pools")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, `{"pools":[{"id":"eth","custom":true}],"meta":null}`, body(rec))
+	assert.Equal(t, "https://pool.example", rec.Header().Get("Access-Control-Allow-Origin"))
+	get("/api/pools/")
+	assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+
+	rec = get("/api/v2/pools/eth/miners/0xabc/earnings/daily?page=1&pageSize=10")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, body(rec), `"amount":1`)
+	assert.Equal(t, "page=1&pageSize=10", lastQuery)
+
+	// unknown parameters are neither passed on nor part of the cache key
+	get("/api/v2/pools/eth/miners/0xabc/earnings/daily?pageSize=10&page=1&nocache=123")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&hits))
+
+	// the v1 api returns the result without meta
+	rec = get("/api/pools/eth/miners/0xabc/earnings/daily")
+	assert.Equal(t, `[{"amount":1,"date":"2022-07-01T00:00:00Z"}]`, body(rec))
+	assert.Equal(t, http.StatusNotFound, get("/api/v2/pools/eth/performance").Code)
+
+	rec = get("/api/pools/btc")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Equal(t, `{"message":"pool not found"}`, body(rec))
+
+	assert.Equal(t, http.StatusForbidden, get("/api/pools/eth/miners/0xbad").Code)
+	assert.Equal(t, http.StatusNotFound, get("/api/pools/eth/unknown").Code)
+	assert.Equal(t, http.StatusNotFound, get("/api/other").Code)
+
+	// the cache expires
+	now = now.Add(2 * time.Minute)
+	get("/api/pools")
+	assert.Equal(t, int32(5), atomic.LoadInt32(&hits))
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/api/pools/eth/miners/0xabc/settings", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodOptions, "/api/pools", nil)
+	req.Header.Set("Origin", "https://evil.example")
+	s.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "Origin", rec.Header().Get("Vary"))
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/pools", nil))
+	assert.Equal(t, "Origin", rec.Header().Get("Vary"))
+}
+
+func TestRateLimit(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"pools":[]}`))
+	}))
+	defer upstream.Close()
+
+	now := time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)
+	s := New(miningcore.New(upstream.URL), WithRateLimit(1, 2), WithForwardedFor(1))
+	s.now = func() time.Time { return now }
+	get := func(ip string) int {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/api/pools", nil)
+		// the first entry is set by the client and can't be trusted
+		req.Header.Set("X-Forwarded-For", "10.0.0.1, "+ip)
+		s.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	assert.Equal(t, http.StatusOK, get("1.1.1.1"))
+	assert.Equal(t, http.StatusOK, get("1.1.1.1"))
+	assert.Equal(t, http.StatusTooManyRequests, get("1.1.1.1"))
+	assert.Equal(t, http.StatusOK, get("2.2.2.2"))
+	now = now.Add(time.Second)
+	assert.Equal(t, http.StatusOK, get("1.1.1.1"))
+	assert.Equal(t, http.StatusTooManyRequests, get("1.1.1.1"))
+}
+
+func TestClientIP(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/pools", nil)
+	req.RemoteAddr = "192.168.0.1:1234"
+	req.Header.Add("X-Forwarded-For", "1.1.1.1, 2.2.2.2")
+	req.Header.Add("X-Forwarded-For", "3.3.3.3")
+
+	assert.Equal(t, "192.168.0.1", New(nil).clientIP(req))
+	assert.Equal(t, "3.3.3.3", New(nil, WithForwardedFor(1)).clientIP(req))
+	assert.Equal(t, "2.2.2.2", New(nil, WithForwardedFor(2)).clientIP(req))
+	// less entries than trusted proxies
+	assert.Equal(t, "192.168.0.1", New(nil, WithForwardedFor(4)).clientIP(req))
+}
+
+func TestCacheEviction(t *testing.T) {
+	s := New(nil, WithCacheSize(2))
+	now := time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Minute)
+	s.store("a", &entry{key: "a", expires: expires})
+	s.store("b", &entry{key: "b", expires: expires})
+	assert.NotNil(t, s.cached("a", now))
+	s.store("c", &entry{key: "c", expires: expires})
+
+	assert.NotNil(t, s.cached("a", now))
+	assert.Nil(t, s.cached("b", now))
+	assert.NotNil(t, s.cached("c", now))
+	assert.Nil(t, s.cached("c", expires))
+	assert.Equal(t, 1, s.lru.Len())
+}
@@ -0,0 +1,144 @@
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+type fetchFunc func(ctx context.Context, c *miningcore.Client, id, addr string, p map[string]string, res *json.RawMessage) (int, error)
+
+// route is a matched api route with its path arguments. The client fetches the paged
+// routes from the v2 api, for requests to the v1 api only the result is passed on.
+type route struct {
+	id     string
+	addr   string
+	fetch  fetchFunc
+	params []string
+	paged  bool
+	unwrap bool
+}
+
+// pagedRoutes are the routes that are available in the v2 api.
+var pagedRoutes = map[string]bool{
+	"blocks":               true,
+	"payments":             true,
+	"miner/payments":       true,
+	"miner/balancechanges": true,
+	"miner/earnings/daily": true,
+}
+
+var pageParams = []string{"page", "pageSize"}
+
+// routeParams are the query parameters the routes accept, all others are dropped.
+var routeParams = map[string][]string{
+	"blocks":               pageParams,
+	"payments":             pageParams,
+	"performance":          {"r", "i"},
+	"miners":               pageParams,
+	"miner":                {"perfMode"},
+	"miner/payments":       pageParams,
+	"miner/balancechanges": pageParams,
+	"miner/earnings/daily": pageParams,
+	"miner/performance":    {"mode"},
+}
+
+var poolRoutes = map[string]fetchFunc{
+	"": func(ctx context.Context, c *miningcore.Client, id, _ string, _ map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalPool(ctx, id, res)
+	},
+	"blocks": func(ctx context.Context, c *miningcore.Client, id, _ string, p map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalPoolBlocks(ctx, id, res, p)
+	},
+	"payments": func(ctx context.Context, c *miningcore.Client, id, _ string, p map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalPoolPayments(ctx, id, res, p)
+	},
+	"performance": func(ctx context.Context, c *miningcore.Client, id, _ string, p map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalPoolPerformance(ctx, id, res, p)
+	},
+	"miners": func(ctx context.Context, c *miningcore.Client, id, _ string, p map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalMiners(ctx, id, res, p)
+	},
+}
+
+var minerRoutes = map[string]fetchFunc{
+	"": func(ctx context.Context, c *miningcore.Client, id, addr string, p map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalMiner(ctx, id, addr, res, p)
+	},
+	"payments": func(ctx context.Context, c *miningcore.Client, id, addr string, p map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalMinerPayments(ctx, id, addr, res, p)
+	},
+	"balancechanges": func(ctx context.Context, c *miningcore.Client, id, addr string, p map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalMinerBalanceChanges(ctx, id, addr, res, p)
+	},
+	"earnings/daily": func(ctx context.Context, c *miningcore.Client, id, addr string, p map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalMinerDailyEarnings(ctx, id, addr, res, p)
+	},
+	"performance": func(ctx context.Context, c *miningcore.Client, id, addr string, p map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalMinerPerformance(ctx, id, addr, res, p)
+	},
+	"settings": func(ctx context.Context, c *miningcore.Client, id, addr string, _ map[string]string, res *json.RawMessage) (int, error) {
+		return c.UnmarshalMinerSettings(ctx, id, addr, res)
+	},
+}
+
+// match maps an escaped request path to its route:
+//
+//	/api/pools
+//	/api/pools/{id}[/blocks|/payments|/performance|/miners]
+//	/api/pools/{id}/miners/{addr}[/payments|/balancechanges|/earnings/daily|/performance|/settings]
+//	/api/v2/pools/{id}[/blocks|/payments]
+//	/api/v2/pools/{id}/miners/{addr}[/payments|/balancechanges|/earnings/daily]
+func match(path string) (route, bool) {
+	path = strings.TrimSuffix(path, "/")
+	v2 := strings.HasPrefix(path, "/api/v2/pools")
+	var rest string
+	switch {
+	case v2:
+		rest = strings.TrimPrefix(path, "/api/v2/pools")
+	case strings.HasPrefix(path, "/api/pools"):
+		rest = strings.TrimPrefix(path, "/api/pools")
+	default:
+		return route{}, false
+	}
+	if rest != "" && rest[0] != '/' {
+		return route{}, false
+	}
+	if rest == "" {
+		if v2 {
+			return route{}, false
+		}
+		return route{fetch: func(ctx context.Context, c *miningcore.Client, _, _ string, _ map[string]string, res *json.RawMessage) (int, error) {
+			return c.UnmarshalPools(ctx, res)
+		}}, true
+	}
+	parts := strings.Split(strings.TrimPrefix(rest, "/"), "/")
+	for i, p := range parts {
+		unescaped, err := url.PathUnescape(p)
+		if err != nil || unescaped == "" {
+			return route{}, false
+		}
+		parts[i] = unescaped
+	}
+	r := route{id: parts[0]}
+	var ok bool
+	if len(parts) >= 3 && parts[1] == "miners" {
+		r.addr = parts[2]
+		sub := strings.Join(parts[3:], "/")
+		r.fetch, ok = minerRoutes[sub]
+		name := strings.TrimSuffix("miner/"+sub, "/")
+		r.paged, r.params = pagedRoutes[name], routeParams[name]
+	} else {
+		sub := strings.Join(parts[1:], "/")
+		r.fetch, ok = poolRoutes[sub]
+		r.paged, r.params = pagedRoutes[sub], routeParams[sub]
+	}
+	if !ok || v2 && !r.paged {
+		return route{}, false
+	}
+	r.unwrap = r.paged && !v2
+	return r, true
+}
@@ -0,0 +1,302 @@
+// Package reconcile cross-checks the payments, balance changes and earnings a pool reports
+// for a miner and lists everything that doesn't add up.
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Kind is the kind of a discrepancy.
+type Kind string
+
+const (
+	// MissingInPool is a miner payment that is not listed in the pool payments.
+	MissingInPool Kind = "missinginpool"
+	// MissingForMiner is a pool payment to the miner that is not listed in the miner payments.
+	MissingForMiner Kind = "missingforminer"
+	// CreditMismatch means the balance credits don't match the daily earnings.
+	CreditMismatch Kind = "creditmismatch"
+	// PayoutMismatch means the balance debits don't match the payments.
+	PayoutMismatch Kind = "payoutmismatch"
+	// BalanceMismatch means credits minus payouts don't match the pending balance.
+	BalanceMismatch Kind = "balancemismatch"
+)
+
+// Discrepancy is a single finding of the reconciliation.
+type Discrepancy struct {
+	Kind        Kind       `json:"kind"`
+	Description string     `json:"description"`
+	Expected    float64    `json:"expected"`
+	Actual      float64    `json:"actual"`
+	TxID        string     `json:"txId,omitempty"`
+	Time        *time.Time `json:"time,omitempty"`
+}
+
+// Input is the data of a miner the reconciliation runs on.
+// PoolPayments may contain payments to other addresses, they are ignored.
+type Input struct {
+	Address        string
+	PoolPayments   []*miningcore.Payment
+	MinerPayments  []*miningcore.Payment
+	BalanceChanges []*miningcore.BalanceChange
+	DailyEarnings  []*miningcore.DailyEarning
+	PendingBalance float64
+}
+
+// Report is the result of a reconciliation. Credits are the positive and Debits the
+// negated negative balance changes within the time range.
+type Report struct {
+	PoolID         string        `json:"poolId"`
+	Address        string        `json:"address"`
+	From           time.Time     `json:"from"`
+	To             time.Time     `json:"to"`
+	Credits        float64       `json:"credits"`
+	Debits         float64       `json:"debits"`
+	Paid           float64       `json:"paid"`
+	PoolPaid       float64       `json:"poolPaid"`
+	Earnings       float64       `json:"earnings"`
+	PendingBalance float64       `json:"pendingBalance"`
+	Discrepancies  []Discrepancy `json:"discrepancies"`
+}
+
+// OK reports whether no discrepancies were found.
+func (r *Report) OK() bool {
+	return len(r.Discrepancies) == 0
+}
+
+// WriteText writes a human readable version of the report.
+func (r *Report) WriteText(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "reconciliation of %s on %s from %s to %s\n"+
+		"credits:         %.8f\ndebits:          %.8f\npaid:            %.8f\npool paid:       %.8f\n"+
+		"earnings:        %.8f\npending balance: %.8f\n",
+		r.Address, r.PoolID, formatTime(r.From), formatTime(r.To),
+		r.Credits, r.Debits, r.Paid, r.PoolPaid, r.Earnings, r.PendingBalance)
+	if err != nil {
+		return err
+	}
+	if r.OK() {
+		_, err = fmt.Fprintln(w, "no discrepancies found")
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%d discrepancies found:\n", len(r.Discrepancies))
+	if err != nil {
+		return err
+	}
+	for _, d := range r.Discrepancies {
+		_, err = fmt.Fprintf(w, "  [%s] %s (expected %.8f, actual %.8f)\n", d.Kind, d.Description, d.Expected, d.Actual)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReconcileOpts are options for the reconciliation.
+type ReconcileOpts func(*options)
+
+type options struct {
+	tolerance float64
+}
+
+// WithTolerance sets the absolute difference up to which amounts are considered equal.
+func WithTolerance(t float64) ReconcileOpts {
+	return func(o *options) {
+		o.tolerance = t
+	}
+}
+
+// Reconcile fetches the history of a miner between from and to and checks it.
+// A zero from covers the whole history, which also enables the pending balance check.
+func Reconcile(ctx context.Context, c *miningcore.Client, id, addr string, from, to time.Time, opts ...ReconcileOpts) (*Report, error) {
+	in := Input{Address: addr}
+	var err error
+	if in.PoolPayments, err = miningcore.Collect(ctx, c.PoolPaymentsPages(id), from, time.Time{}, paymentCreated); err != nil {
+		return nil, err
+	}
+	if in.MinerPayments, err = miningcore.Collect(ctx, c.MinerPaymentsPages(id, addr), from, time.Time{}, paymentCreated); err != nil {
+		return nil, err
+	}
+	if in.BalanceChanges, err = miningcore.Collect(ctx, c.MinerBalanceChangesPages(id, addr), from, time.Time{}, balanceChangeCreated); err != nil {
+		return nil, err
+	}
+	if in.DailyEarnings, err = miningcore.Collect(ctx, c.MinerDailyEarningsPages(id, addr), from, time.Time{}, dailyEarningDate); err != nil {
+		return nil, err
+	}
+	miner, _, err := c.GetMiner(ctx, id, addr)
+	if err != nil {
+		return nil, err
+	}
+	in.PendingBalance = miner.PendingBalance
+
+	r, err := Check(in, from, to, opts...)
+	if err != nil {
+		return nil, err
+	}
+	r.PoolID = id
+	return r, nil
+}
+
+// Check reconciles the given input for the time range between from and to.
+// A zero to means no upper limit.
+func Check(in Input, from, to time.Time, opts ...ReconcileOpts) (*Report, error) {
+	o := &options{tolerance: 1e-8}
+	for _, opt := range opts {
+		opt(o)
+	}
+	r := &Report{Address: in.Address, From: from, To: to, PendingBalance: in.PendingBalance}
+	inRange := func(t time.Time) bool {
+		return !t.Before(from) && (to.IsZero() || t.Before(to))
+	}
+
+	minerPayments, err := filter(in.MinerPayments, paymentCreated, inRange)
+	if err != nil {
+		return nil, err
+	}
+	poolPayments, err := filter(in.PoolPayments, paymentCreated, inRange)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := filter(in.BalanceChanges, balanceChangeCreated, inRange)
+	if err != nil {
+		return nil, err
+	}
+	earnings, err := filter(in.DailyEarnings, dailyEarningDate, inRange)
+	if err != nil {
+		return nil, err
+	}
+
+	poolByKey := make(map[string]int)
+	for _, p := range poolPayments {
+		if p.Address != in.Address {
+			continue
+		}
+		r.PoolPaid += p.Amount
+		poolByKey[paymentKey(p)]++
+	}
+	for _, p := range minerPayments {
+		r.Paid += p.Amount
+		key := paymentKey(p)
+		if poolByKey[key] > 0 {
+			poolByKey[key]--
+			continue
+		}
+		r.add(Discrepancy{
+			Kind:        MissingInPool,
+			Description: fmt.Sprintf("payment of %.8f in %s is not listed in the pool payments", p.Amount, p.TransactionConfirmationData),
+			Expected:    p.Amount,
+			TxID:        p.TransactionConfirmationData,
+			Time:        parseTime(p.Created),
+		})
+	}
+	for _, p := range poolPayments {
+		key := paymentKey(p)
+		if p.Address != in.Address || poolByKey[key] == 0 {
+			continue
+		}
+		poolByKey[key]--
+		r.add(Discrepancy{
+			Kind:        MissingForMiner,
+			Description: fmt.Sprintf("pool payment of %.8f in %s is not listed in the miner payments", p.Amount, p.TransactionConfirmationData),
+			Expected:    p.Amount,
+			TxID:        p.TransactionConfirmationData,
+			Time:        parseTime(p.Created),
+		})
+	}
+
+	for _, c := range changes {
+		if c.Amount >= 0 {
+			r.Credits += c.Amount
+		} else {
+			r.Debits -= c.Amount
+		}
+	}
+	for _, e := range earnings {
+		r.Earnings += e.Amount
+	}
+
+	if len(earnings) > 0 && !equal(r.Credits, r.Earnings, o.tolerance) {
+		r.add(Discrepancy{
+			Kind:        CreditMismatch,
+			Description: "balance credits don't match the daily earnings",
+			Expected:    r.Earnings,
+			Actual:      r.Credits,
+		})
+	}
+	if !equal(r.Debits, r.Paid, o.tolerance) {
+		r.add(Discrepancy{
+			Kind:        PayoutMismatch,
+			Description: "balance debits don't match the payments",
+			Expected:    r.Paid,
+			Actual:      r.Debits,
+		})
+	}
+	if from.IsZero() && to.IsZero() && !equal(r.Credits-r.Debits, r.PendingBalance, o.tolerance) {
+		r.add(Discrepancy{
+			Kind:        BalanceMismatch,
+			Description: "credits minus payouts don't match the pending balance",
+			Expected:    r.Credits - r.Debits,
+			Actual:      r.PendingBalance,
+		})
+	}
+	return r, nil
+}
+
+func (r *Report) add(d Discrepancy) {
+	r.Discrepancies = append(r.Discrepancies, d)
+}
+
+func filter[T any](items []T, created func(T) string, keep func(time.Time) bool) ([]T, error) {
+	var res []T
+	for _, item := range items {
+		t, err := miningcore.ParseTime(created(item))
+		if err != nil {
+			return nil, err
+		}
+		if keep(t) {
+			res = append(res, item)
+		}
+	}
+	return res, nil
+}
+
+// parseTime returns nil if s is not a valid timestamp.
+func parseTime(s string) *time.Time {
+	t, err := miningcore.ParseTime(s)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func paymentCreated(p *miningcore.Payment) string {
+	return p.Created
+}
+
+func balanceChangeCreated(c *miningcore.BalanceChange) string {
+	return c.Created
+}
+
+func dailyEarningDate(e *miningcore.DailyEarning) string {
+	return e.Date
+}
+
+func paymentKey(p *miningcore.Payment) string {
+	return fmt.Sprintf("%s/%.8f", p.TransactionConfirmationData, p.Amount)
+}
+
+func equal(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,61 @@
+package reconcile
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	in := Input{
+		Address: "0xabc",
+		PoolPayments: []*miningcore.Payment{
+			{Address: "0xabc", Amount: 1, TransactionConfirmationData: "0x1", Created: "2022-07-02T00:00:00Z"},
+			{Address: "0xdef", Amount: 3, TransactionConfirmationData: "0x1", Created: "2022-07-02T00:00:00Z"},
+			{Address: "0xabc", Amount: 0.5, TransactionConfirmationData: "0x3", Created: "2022-07-04T00:00:00Z"},
+		},
+		MinerPayments: []*miningcore.Payment{
+			{Address: "0xabc", Amount: 1, TransactionConfirmationData: "0x1", Created: "2022-07-02T00:00:00Z"},
+			{Address: "0xabc", Amount: 0.5, TransactionConfirmationData: "0x2", Created: "2022-07-03T00:00:00Z"},
+		},
+		BalanceChanges: []*miningcore.BalanceChange{
+			{Amount: 1.2, Created: "2022-07-01T00:00:00Z"},
+			{Amount: -1, Created: "2022-07-02T00:00:00Z"},
+			{Amount: 0.5, Created: "2022-07-02T12:00:00Z"},
+			{Amount: -0.5, Created: "2022-07-03T00:00:00Z"},
+		},
+		DailyEarnings: []*miningcore.DailyEarning{
+			{Amount: 1.2, Date: "2022-07-01"},
+			{Amount: 0.4, Date: "2022-07-02"},
+		},
+		PendingBalance: 0.2,
+	}
+
+	r, err := Check(in, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.InDelta(t, 1.7, r.Credits, 1e-9)
+	assert.InDelta(t, 1.5, r.Debits, 1e-9)
+	assert.InDelta(t, 1.5, r.Paid, 1e-9)
+	assert.InDelta(t, 1.5, r.PoolPaid, 1e-9)
+
+	var kinds []Kind
+	for _, d := range r.Discrepancies {
+		kinds = append(kinds, d.Kind)
+	}
+	assert.Equal(t, []Kind{MissingInPool, MissingForMiner, CreditMismatch}, kinds)
+	assert.Equal(t, "0x2", r.Discrepancies[0].TxID)
+	assert.Equal(t, "0x3", r.Discrepancies[1].TxID)
+	assert.Equal(t, time.Date(2022, 7, 3, 0, 0, 0, 0, time.UTC), r.Discrepancies[0].Time.UTC())
+	assert.Nil(t, r.Discrepancies[2].Time)
+
+	r, err = Check(in, time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 7, 2, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.True(t, r.OK(), r.Discrepancies)
+
+	var buf bytes.Buffer
+	assert.NoError(t, r.WriteText(&buf))
+	assert.Contains(t, buf.String(), "no discrepancies found")
+}
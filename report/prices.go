@@ -0,0 +1,100 @@
+package report
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// ErrNoPrice is returned if a price source has no price for a coin at a given time.
+var ErrNoPrice = errors.New("no price available")
+
+// PriceSource provides historical fiat prices of coins.
+type PriceSource interface {
+	Price(symbol string, t time.Time) (float64, error)
+}
+
+// CSVPrices is a PriceSource backed by a table of historical prices.
+// The price of a day is the latest price at or before it.
+type CSVPrices struct {
+	prices map[string][]price
+}
+
+type price struct {
+	time  time.Time
+	value float64
+}
+
+// LoadCSVPrices reads historical prices from a CSV file, see ReadCSVPrices.
+func LoadCSVPrices(path string) (*CSVPrices, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadCSVPrices(f)
+}
+
+// ReadCSVPrices reads historical prices in CSV format. The first line is a header with
+// a "date" and a "price" column and an optional "symbol" column. Rows without a symbol
+// apply to every coin.
+func ReadCSVPrices(r io.Reader) (*CSVPrices, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("empty price file")
+	}
+	cols := map[string]int{"date": -1, "price": -1, "symbol": -1}
+	for i, name := range rows[0] {
+		if _, ok := cols[strings.ToLower(strings.TrimSpace(name))]; ok {
+			cols[strings.ToLower(strings.TrimSpace(name))] = i
+		}
+	}
+	if cols["date"] < 0 || cols["price"] < 0 {
+		return nil, errors.New("price file needs a date and a price column")
+	}
+
+	p := &CSVPrices{prices: make(map[string][]price)}
+	for n, row := range rows[1:] {
+		t, err := miningcore.ParseTime(strings.TrimSpace(row[cols["date"]]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+2, err)
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(row[cols["price"]]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n+2, err)
+		}
+		var symbol string
+		if cols["symbol"] >= 0 {
+			symbol = strings.ToUpper(strings.TrimSpace(row[cols["symbol"]]))
+		}
+		p.prices[symbol] = append(p.prices[symbol], price{time: t, value: v})
+	}
+	for _, list := range p.prices {
+		sort.Slice(list, func(i, j int) bool { return list[i].time.Before(list[j].time) })
+	}
+	return p, nil
+}
+
+// Price implements PriceSource.
+func (p *CSVPrices) Price(symbol string, t time.Time) (float64, error) {
+	list, ok := p.prices[strings.ToUpper(symbol)]
+	if !ok {
+		list = p.prices[""]
+	}
+	i := sort.Search(len(list), func(i int) bool { return list[i].time.After(t) })
+	if i == 0 {
+		return 0, fmt.Errorf("%w: %s at %s", ErrNoPrice, symbol, t.Format("2006-01-02"))
+	}
+	return list[i-1].value, nil
+}
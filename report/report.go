@@ -0,0 +1,283 @@
+// Package report builds income reports for a miner address that are suitable for
+// accounting and tax purposes.
+package report
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Granularity is the length of the periods a report is grouped by.
+type Granularity string
+
+const (
+	Day   Granularity = "day"
+	Month Granularity = "month"
+	Year  Granularity = "year"
+)
+
+// Input is the history of a miner a report is built from.
+// Income is taken from the daily earnings, or from the positive balance changes if there are none.
+type Input struct {
+	Address        string
+	Symbol         string
+	Payments       []*miningcore.Payment
+	BalanceChanges []*miningcore.BalanceChange
+	DailyEarnings  []*miningcore.DailyEarning
+}
+
+// Row is the income and the payments of a single period.
+// Fiat values are computed per day and summed up, they are zero if no price source is set.
+type Row struct {
+	Period     string    `json:"period"`
+	Start      time.Time `json:"start"`
+	Income     float64   `json:"income"`
+	IncomeFiat float64   `json:"incomeFiat"`
+	Paid       float64   `json:"paid"`
+	PaidFiat   float64   `json:"paidFiat"`
+	TxIDs      []string  `json:"txIds"`
+}
+
+// Report is the income of a miner grouped by period, oldest first.
+type Report struct {
+	Address     string      `json:"address"`
+	Symbol      string      `json:"symbol"`
+	Currency    string      `json:"currency,omitempty"`
+	Granularity Granularity `json:"granularity"`
+	Rows        []*Row      `json:"rows"`
+	Total       Row         `json:"total"`
+}
+
+// ReportOpts are options for building a report.
+type ReportOpts func(*options)
+
+type options struct {
+	granularity Granularity
+	prices      PriceSource
+	currency    string
+}
+
+// WithGranularity sets the period length, the default is Month.
+func WithGranularity(g Granularity) ReportOpts {
+	return func(o *options) {
+		o.granularity = g
+	}
+}
+
+// WithPrices sets the source of fiat prices and the name of the fiat currency.
+func WithPrices(p PriceSource, currency string) ReportOpts {
+	return func(o *options) {
+		o.prices = p
+		o.currency = currency
+	}
+}
+
+// Fetch loads the history of a miner between from and to. A zero to means no upper limit.
+func Fetch(ctx context.Context, c *miningcore.Client, id, addr string, from, to time.Time) (Input, error) {
+	in := Input{Address: addr}
+	pool, _, err := c.GetPool(ctx, id)
+	if err != nil {
+		return in, err
+	}
+	if pool.Coin != nil {
+		in.Symbol = pool.Coin.Symbol
+	}
+	if in.Payments, err = miningcore.Collect(ctx, c.MinerPaymentsPages(id, addr), from, to, func(p *miningcore.Payment) string { return p.Created }); err != nil {
+		return in, err
+	}
+	if in.BalanceChanges, err = miningcore.Collect(ctx, c.MinerBalanceChangesPages(id, addr), from, to, func(b *miningcore.BalanceChange) string { return b.Created }); err != nil {
+		return in, err
+	}
+	if in.DailyEarnings, err = miningcore.Collect(ctx, c.MinerDailyEarningsPages(id, addr), from, to, func(d *miningcore.DailyEarning) string { return d.Date }); err != nil {
+		return in, err
+	}
+	return in, nil
+}
+
+// Build groups the history of a miner into periods.
+func Build(in Input, opts ...ReportOpts) (*Report, error) {
+	o := &options{granularity: Month}
+	for _, opt := range opts {
+		opt(o)
+	}
+	r := &Report{
+		Address:     in.Address,
+		Symbol:      in.Symbol,
+		Currency:    o.currency,
+		Granularity: o.granularity,
+		Total:       Row{Period: "total"},
+	}
+
+	rows := make(map[string]*Row)
+	row := func(t time.Time) *Row {
+		start := periodStart(t, o.granularity)
+		key := periodName(start, o.granularity)
+		if _, ok := rows[key]; !ok {
+			rows[key] = &Row{Period: key, Start: start}
+		}
+		return rows[key]
+	}
+	fiat := func(amount float64, t time.Time) (float64, error) {
+		if o.prices == nil {
+			return 0, nil
+		}
+		p, err := o.prices.Price(in.Symbol, t)
+		if err != nil {
+			return 0, err
+		}
+		return amount * p, nil
+	}
+
+	income, err := dailyIncome(in)
+	if err != nil {
+		return nil, err
+	}
+	for day, amount := range income {
+		v, err := fiat(amount, day)
+		if err != nil {
+			return nil, err
+		}
+		rw := row(day)
+		rw.Income += amount
+		rw.IncomeFiat += v
+	}
+	for _, p := range in.Payments {
+		t, err := miningcore.ParseTime(p.Created)
+		if err != nil {
+			return nil, err
+		}
+		v, err := fiat(p.Amount, t)
+		if err != nil {
+			return nil, err
+		}
+		rw := row(t)
+		rw.Paid += p.Amount
+		rw.PaidFiat += v
+		if p.TransactionConfirmationData != "" {
+			rw.TxIDs = append(rw.TxIDs, p.TransactionConfirmationData)
+		}
+	}
+
+	for _, rw := range rows {
+		sort.Strings(rw.TxIDs)
+		r.Rows = append(r.Rows, rw)
+		r.Total.Income += rw.Income
+		r.Total.IncomeFiat += rw.IncomeFiat
+		r.Total.Paid += rw.Paid
+		r.Total.PaidFiat += rw.PaidFiat
+		r.Total.TxIDs = append(r.Total.TxIDs, rw.TxIDs...)
+	}
+	sort.Slice(r.Rows, func(i, j int) bool { return r.Rows[i].Start.Before(r.Rows[j].Start) })
+	if len(r.Rows) > 0 {
+		r.Total.Start = r.Rows[0].Start
+	}
+	sort.Strings(r.Total.TxIDs)
+	return r, nil
+}
+
+// WriteCSV writes one line per period. Transaction ids are separated by spaces.
+func (r *Report) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := []string{"period", "income", "paid", "transactions"}
+	if r.Currency != "" {
+		header = []string{"period", "income", "income_" + r.Currency, "paid", "paid_" + r.Currency, "transactions"}
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, rw := range r.Rows {
+		line := []string{rw.Period, formatFloat(rw.Income), formatFloat(rw.Paid), strings.Join(rw.TxIDs, " ")}
+		if r.Currency != "" {
+			line = []string{rw.Period, formatFloat(rw.Income), formatFiat(rw.IncomeFiat), formatFloat(rw.Paid), formatFiat(rw.PaidFiat), strings.Join(rw.TxIDs, " ")}
+		}
+		if err := cw.Write(line); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteText writes a human readable summary of the report.
+func (r *Report) WriteText(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "income report of %s (%s) per %s\n\n", r.Address, r.Symbol, r.Granularity); err != nil {
+		return err
+	}
+	rows := append(append([]*Row(nil), r.Rows...), &r.Total)
+	for _, rw := range rows {
+		line := fmt.Sprintf("%-10s  income %16.8f %s  paid %16.8f %s  %d transactions",
+			rw.Period, rw.Income, r.Symbol, rw.Paid, r.Symbol, len(rw.TxIDs))
+		if r.Currency != "" {
+			line += fmt.Sprintf("  (income %.2f %s, paid %.2f %s)", rw.IncomeFiat, r.Currency, rw.PaidFiat, r.Currency)
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dailyIncome sums the income of the miner per UTC day.
+func dailyIncome(in Input) (map[time.Time]float64, error) {
+	res := make(map[time.Time]float64)
+	if len(in.DailyEarnings) > 0 {
+		for _, e := range in.DailyEarnings {
+			t, err := miningcore.ParseTime(e.Date)
+			if err != nil {
+				return nil, err
+			}
+			res[periodStart(t, Day)] += e.Amount
+		}
+		return res, nil
+	}
+	for _, c := range in.BalanceChanges {
+		if c.Amount <= 0 {
+			continue
+		}
+		t, err := miningcore.ParseTime(c.Created)
+		if err != nil {
+			return nil, err
+		}
+		res[periodStart(t, Day)] += c.Amount
+	}
+	return res, nil
+}
+
+func periodStart(t time.Time, g Granularity) time.Time {
+	t = t.UTC()
+	switch g {
+	case Year:
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	case Month:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+}
+
+func periodName(start time.Time, g Granularity) string {
+	switch g {
+	case Year:
+		return start.Format("2006")
+	case Month:
+		return start.Format("2006-01")
+	default:
+		return start.Format("2006-01-02")
+	}
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func formatFiat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 2, 64)
+}
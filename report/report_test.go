@@ -0,0 +1,82 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func testInput() Input {
+	return Input{
+		Address: "0xabc",
+		Symbol:  "ETH",
+		Payments: []*miningcore.Payment{
+			{Amount: 1, TransactionConfirmationData: "0x2", Created: "2022-07-02T10:00:00Z"},
+			{Amount: 0.5, TransactionConfirmationData: "0x1", Created: "2022-06-30T10:00:00Z"},
+		},
+		DailyEarnings: []*miningcore.DailyEarning{
+			{Amount: 0.2, Date: "2022-07-02"},
+			{Amount: 0.3, Date: "2022-07-01"},
+			{Amount: 0.5, Date: "2022-06-30"},
+		},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	prices, err := ReadCSVPrices(strings.NewReader("date,symbol,price\n2022-06-30,ETH,1000\n2022-07-02,ETH,2000\n"))
+	assert.NoError(t, err)
+
+	r, err := Build(testInput(), WithPrices(prices, "USD"))
+	assert.NoError(t, err)
+	assert.Len(t, r.Rows, 2)
+	assert.Equal(t, "2022-06", r.Rows[0].Period)
+	assert.InDelta(t, 0.5, r.Rows[0].Income, 1e-9)
+	assert.InDelta(t, 500, r.Rows[0].IncomeFiat, 1e-9)
+	assert.Equal(t, []string{"0x1"}, r.Rows[0].TxIDs)
+	assert.Equal(t, "2022-07", r.Rows[1].Period)
+	assert.InDelta(t, 0.5, r.Rows[1].Income, 1e-9)
+	assert.InDelta(t, 0.3*1000+0.2*2000, r.Rows[1].IncomeFiat, 1e-9)
+	assert.InDelta(t, 2000, r.Rows[1].PaidFiat, 1e-9)
+	assert.InDelta(t, 1.5, r.Total.Paid, 1e-9)
+
+	var buf bytes.Buffer
+	assert.NoError(t, r.WriteCSV(&buf))
+	assert.Equal(t, "period,income,income_USD,paid,paid_USD,transactions\n"+
+		"2022-06,0.5,500.00,0.5,500.00,0x1\n"+
+		"2022-07,0.5,700.00,1,2000.00,0x2\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(t, r.WriteText(&buf))
+	assert.Contains(t, buf.String(), "total")
+}
+
+func TestBuildFromBalanceChanges(t *testing.T) {
+	in := testInput()
+	in.DailyEarnings = nil
+	in.BalanceChanges = []*miningcore.BalanceChange{
+		{Amount: 0.4, Created: "2021-12-31T23:00:00Z"},
+		{Amount: -0.4, Created: "2022-01-01T01:00:00Z"},
+		{Amount: 0.1, Created: "2022-01-01T02:00:00Z"},
+	}
+	r, err := Build(in, WithGranularity(Year))
+	assert.NoError(t, err)
+	assert.Len(t, r.Rows, 2)
+	assert.Equal(t, "2021", r.Rows[0].Period)
+	assert.InDelta(t, 0.4, r.Rows[0].Income, 1e-9)
+	assert.InDelta(t, 0.1, r.Rows[1].Income, 1e-9)
+	assert.Equal(t, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC), r.Total.Start)
+}
+
+func TestCSVPricesMissing(t *testing.T) {
+	prices, err := ReadCSVPrices(strings.NewReader("date,price\n2022-07-01,10\n"))
+	assert.NoError(t, err)
+	_, err = prices.Price("ETH", time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+	assert.ErrorIs(t, err, ErrNoPrice)
+	p, err := prices.Price("ETH", time.Date(2022, 7, 5, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, 10.0, p)
+}
@@ -0,0 +1,334 @@
+package rpc
+
+import (
+	"encoding/json"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/rpc/pb"
+)
+
+func convert[T, R any](items []T, fn func(T) R) []R {
+	res := make([]R, 0, len(items))
+	for _, item := range items {
+		res = append(res, fn(item))
+	}
+	return res
+}
+
+func pool(p *miningcore.PoolInfo) *pb.Pool {
+	res := &pb.Pool{
+		Id:                      p.ID,
+		Coin:                    coin(p.Coin),
+		PaymentProcessing:       paymentProcessing(p.PaymentProcessing),
+		ClientConnectionTimeout: p.ClientConnectionTimeout,
+		JobRebroadcastTimeout:   p.JobRebroadcastTimeout,
+		BlockRefreshInterval:    p.BlockRefreshInterval,
+		PoolFeePercent:          p.PoolFeePercent,
+		Address:                 p.Address,
+		AddressInfoLink:         p.AddressInfoLink,
+		TopMiners:               convert(p.TopMiners, minerPerformance),
+		TotalPaid:               p.TotalPaid,
+		TotalBlocks:             p.TotalBlocks,
+		TotalConfirmedBlocks:    p.TotalConfirmedBlocks,
+		LastPoolBlockTime:       p.LastPoolBlockTime,
+		PoolEffort:              p.PoolEffort,
+		ApiEndpoint:             p.APIEndpoint,
+	}
+	if len(p.Ports) > 0 {
+		res.Ports = make(map[string]*pb.PoolEndpoint, len(p.Ports))
+		for port, e := range p.Ports {
+			res.Ports[port] = endpoint(e)
+		}
+	}
+	if b := p.ShareBasedBanning; b != nil {
+		res.ShareBasedBanning = &pb.ShareBasedBanning{
+			Enabled:        b.Enabeld,
+			CheckThreshold: b.CheckThresghold,
+			InvalidPercent: b.InvalidPercent,
+			Time:           b.Time,
+		}
+	}
+	if s := p.PoolStats; s != nil {
+		res.PoolStats = &pb.PoolStats{
+			LastPoolBlockTime: s.LastPoolBlockTime,
+			ConnectedMiners:   s.ConnectedMiners,
+			PoolHashrate:      float64(s.PoolHashrate),
+			SharesPerSecond:   s.SharesPerSecond,
+		}
+	}
+	if s := p.NetworkStats; s != nil {
+		res.NetworkStats = &pb.NetworkStats{
+			NetworkType:          s.NetworkType,
+			NetworkHashrate:      float64(s.NetworkHashrate),
+			NetworkDifficulty:    float64(s.NetworkDifficulty),
+			NextNetworkTarget:    s.NextNetworkTarget,
+			NextNetworkBits:      s.NextNetworkBits,
+			LastNetworkBlockTime: s.LastNetworkBlockTime,
+			BlockHeight:          s.BlockHeight,
+			ConnectedPeers:       s.ConnectedPeers,
+			RewardType:           s.RewardType,
+		}
+	}
+	return res
+}
+
+func coin(c *miningcore.APICoinConfig) *pb.Coin {
+	if c == nil {
+		return nil
+	}
+	return &pb.Coin{
+		Type:          c.Type,
+		Name:          c.Name,
+		Symbol:        c.Symbol,
+		Website:       c.Website,
+		Market:        c.Market,
+		Family:        string(c.Family),
+		Algorithm:     c.Algorithm,
+		Twitter:       c.Twitter,
+		Discord:       c.Discord,
+		Telegram:      c.Telegram,
+		CanonicalName: c.CanonicalName,
+	}
+}
+
+func endpoint(e miningcore.PoolEndpoint) *pb.PoolEndpoint {
+	res := &pb.PoolEndpoint{
+		ListenAddress: e.ListenAddress,
+		Name:          e.Name,
+		Difficulty:    float64(e.Difficulty),
+		Tls:           e.TLS,
+		TlsAuto:       e.TLSAuto,
+	}
+	if p := e.TCPProxyProtocol; p != nil {
+		res.TcpProxyProtocol = &pb.TcpProxyProtocol{
+			Enable:         p.Enable,
+			Mandatory:      p.Mandatory,
+			ProxyAddresses: p.ProxyAddresses,
+		}
+	}
+	if v := e.VarDiff; v != nil {
+		res.VarDiff = &pb.VarDiff{
+			MinDiff:         float64(v.MinDiff),
+			MaxDelta:        v.MaxDelta,
+			TargetTime:      v.TargetTime,
+			RetargetTime:    v.RetargetTime,
+			VariancePercent: v.VariancePercent,
+		}
+		if v.MaxDiff != nil {
+			d := float64(*v.MaxDiff)
+			res.VarDiff.MaxDiff = &d
+		}
+	}
+	return res
+}
+
+func paymentProcessing(p *miningcore.APIPoolPaymentProcessingConfig) *pb.PaymentProcessing {
+	if p == nil {
+		return nil
+	}
+	res := &pb.PaymentProcessing{
+		Enabled:        p.Enabled,
+		MinimumPayment: p.MinimumPayment,
+		PayoutScheme:   string(p.PayoutScheme),
+	}
+	if p.PayoutSchemeConfig != nil {
+		if b, err := json.Marshal(p.PayoutSchemeConfig); err == nil {
+			res.PayoutSchemeConfig = string(b)
+		}
+	}
+	if p.Extra != nil {
+		if b, err := json.Marshal(p.Extra); err == nil {
+			res.Extra = string(b)
+		}
+	}
+	return res
+}
+
+func minerPerformance(m *miningcore.MinerPerformanceStats) *pb.MinerPerformance {
+	return &pb.MinerPerformance{
+		Miner:           m.Miner,
+		Hashrate:        float64(m.Hashrate),
+		SharesPerSecond: m.SharesPerSecond,
+	}
+}
+
+func poolPerformance(p *miningcore.PoolPerformance) *pb.PoolPerformance {
+	return &pb.PoolPerformance{
+		PoolHashrate:         float64(p.PoolHashrate),
+		ConnectedMiners:      p.ConnectedMiners,
+		ValidSharesPerSecond: p.ValidSharesPerSecond,
+		NetworkHashrate:      float64(p.NetworkHashrate),
+		NetworkDifficulty:    float64(p.NetworkDifficulty),
+		Created:              p.Created,
+	}
+}
+
+func block(b *miningcore.Block) *pb.Block {
+	return &pb.Block{
+		PoolId:                      b.PoolID,
+		BlockHeight:                 b.BlockHeight,
+		NetworkDifficulty:           float64(b.NetworkDifficulty),
+		Status:                      string(b.Status),
+		Type:                        b.Type,
+		ConfirmationProgress:        b.ConfirmationProgress,
+		Effort:                      b.Effort,
+		TransactionConfirmationData: b.TransactionConfirmationData,
+		Reward:                      b.Reward,
+		InfoLink:                    b.InfoLink,
+		Hash:                        b.Hash,
+		Miner:                       b.Miner,
+		Source:                      b.Source,
+		Created:                     b.Created,
+	}
+}
+
+func payment(p *miningcore.Payment) *pb.Payment {
+	return &pb.Payment{
+		Coin:                        p.Coin,
+		Address:                     p.Address,
+		AddressInfoLink:             p.AddressInfoLink,
+		Amount:                      p.Amount,
+		TransactionConfirmationData: p.TransactionConfirmationData,
+		TransactionInfoLink:         p.TransactionInfoLink,
+		Created:                     p.Created,
+	}
+}
+
+func balanceChange(c *miningcore.BalanceChange) *pb.BalanceChange {
+	return &pb.BalanceChange{
+		PoolId:  c.PoolID,
+		Address: c.Address,
+		Amount:  c.Amount,
+		Usage:   c.Usage,
+		Created: c.Created,
+	}
+}
+
+func dailyEarning(e *miningcore.DailyEarning) *pb.DailyEarning {
+	return &pb.DailyEarning{Amount: e.Amount, Date: e.Date}
+}
+
+func miner(m *miningcore.MinerStats) *pb.Miner {
+	res := &pb.Miner{
+		PendingShares:      m.PendingShares,
+		PendingBalance:     m.PendingBalance,
+		TotalPaid:          m.TotalPaid,
+		TodayPaid:          m.TodayPaid,
+		LastPayment:        m.LastPayment,
+		LastPaymentLink:    m.LastPaymentLink,
+		PerformanceSamples: convert(m.PerformanceSamples, workerStats),
+	}
+	if m.Performance != nil {
+		res.Performance = workerStats(m.Performance)
+	}
+	return res
+}
+
+func workerStats(s *miningcore.WorkerStats) *pb.WorkerStats {
+	res := &pb.WorkerStats{
+		Created: s.Created,
+		Workers: make(map[string]*pb.WorkerPerformance, len(s.Workers)),
+	}
+	for name, w := range s.Workers {
+		if w == nil {
+			continue
+		}
+		res.Workers[name] = &pb.WorkerPerformance{
+			Hashrate:         float64(w.Hashrate),
+			ReportedHashrate: float64(w.ReportedHashrate),
+			SharesPerSecond:  w.SharesPerSecond,
+		}
+	}
+	return res
+}
+
+func blockInfo(m miningcore.BlockMessage) *pb.BlockInfo {
+	return &pb.BlockInfo{
+		PoolId:      m.PoolID,
+		BlockHeight: m.BlockHeight,
+		Symbol:      m.Symbol,
+		Name:        m.Name,
+	}
+}
+
+// notification converts a typed notification and returns it along with its pool id.
+// Both pointer and value messages are accepted, nil and unknown types return nil.
+func notification(n miningcore.Notification) (*pb.Notification, string) {
+	switch m := n.(type) {
+	case *miningcore.BlockFoundMessage:
+		if m != nil {
+			return notification(*m)
+		}
+	case *miningcore.ChainHeightMessage:
+		if m != nil {
+			return notification(*m)
+		}
+	case *miningcore.BlockUnlockedMessage:
+		if m != nil {
+			return notification(*m)
+		}
+	case *miningcore.BlockUnlockProgressMessage:
+		if m != nil {
+			return notification(*m)
+		}
+	case *miningcore.PaymentMessage:
+		if m != nil {
+			return notification(*m)
+		}
+	case *miningcore.HashRateUpdateMessage:
+		if m != nil {
+			return notification(*m)
+		}
+
+	case miningcore.BlockFoundMessage:
+		return &pb.Notification{Message: &pb.Notification_BlockFound{BlockFound: &pb.BlockFound{
+			Block:             blockInfo(m.BlockMessage),
+			Miner:             m.Miner,
+			MinerExplorerLink: m.MinerExplorerLink,
+			Source:            m.Source,
+		}}}, m.PoolID
+	case miningcore.ChainHeightMessage:
+		return &pb.Notification{Message: &pb.Notification_ChainHeight{ChainHeight: &pb.ChainHeight{
+			Block: blockInfo(m.BlockMessage),
+		}}}, m.PoolID
+	case miningcore.BlockUnlockedMessage:
+		return &pb.Notification{Message: &pb.Notification_BlockUnlocked{BlockUnlocked: &pb.BlockUnlocked{
+			Block:             blockInfo(m.BlockMessage),
+			BlockType:         m.BlockType,
+			BlockHash:         m.BlockHash,
+			Reward:            m.Reward,
+			Effort:            m.Effort,
+			Miner:             m.Miner,
+			ExplorerLink:      m.ExplorerLink,
+			MinerExplorerLink: m.MinerExplorerLink,
+		}}}, m.PoolID
+	case miningcore.BlockUnlockProgressMessage:
+		return &pb.Notification{Message: &pb.Notification_BlockUnlockProgress{BlockUnlockProgress: &pb.BlockUnlockProgress{
+			Block:    blockInfo(m.BlockMessage),
+			Progress: m.Progress,
+			Effort:   m.Effort,
+		}}}, m.PoolID
+	case miningcore.PaymentMessage:
+		p := &pb.PaymentNotification{
+			PoolId:          m.PoolID,
+			Symbol:          m.Symbol,
+			TxFee:           m.TxFee,
+			TxIds:           m.TxIDs,
+			TxExplorerLinks: m.TxExplorerLinks,
+			RecipientsCount: int32(m.RecipientsCount),
+			Amount:          m.Amount,
+		}
+		if m.Error != nil {
+			p.Error = m.Error.Error()
+		}
+		return &pb.Notification{Message: &pb.Notification_Payment{Payment: p}}, m.PoolID
+	case miningcore.HashRateUpdateMessage:
+		return &pb.Notification{Message: &pb.Notification_HashrateUpdate{HashrateUpdate: &pb.HashrateUpdate{
+			PoolId:   m.PoolID,
+			Hashrate: float64(m.Hashrate),
+			Miner:    m.Miner,
+			Worker:   m.Worker,
+		}}}, m.PoolID
+	}
+	return nil, ""
+}
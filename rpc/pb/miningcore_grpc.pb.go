@@ -0,0 +1,548 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: miningcore.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Miningcore_GetPools_FullMethodName               = "/miningcore.v1.Miningcore/GetPools"
+	Miningcore_GetPool_FullMethodName                = "/miningcore.v1.Miningcore/GetPool"
+	Miningcore_GetPoolPerformance_FullMethodName     = "/miningcore.v1.Miningcore/GetPoolPerformance"
+	Miningcore_GetPoolBlocks_FullMethodName          = "/miningcore.v1.Miningcore/GetPoolBlocks"
+	Miningcore_GetPoolPayments_FullMethodName        = "/miningcore.v1.Miningcore/GetPoolPayments"
+	Miningcore_GetMiners_FullMethodName              = "/miningcore.v1.Miningcore/GetMiners"
+	Miningcore_GetMiner_FullMethodName               = "/miningcore.v1.Miningcore/GetMiner"
+	Miningcore_GetMinerPayments_FullMethodName       = "/miningcore.v1.Miningcore/GetMinerPayments"
+	Miningcore_GetMinerBalanceChanges_FullMethodName = "/miningcore.v1.Miningcore/GetMinerBalanceChanges"
+	Miningcore_GetMinerDailyEarnings_FullMethodName  = "/miningcore.v1.Miningcore/GetMinerDailyEarnings"
+	Miningcore_GetMinerPerformance_FullMethodName    = "/miningcore.v1.Miningcore/GetMinerPerformance"
+	Miningcore_Subscribe_FullMethodName              = "/miningcore.v1.Miningcore/Subscribe"
+)
+
+// MiningcoreClient is the client API for Miningcore service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MiningcoreClient interface {
+	GetPools(ctx context.Context, in *GetPoolsRequest, opts ...grpc.CallOption) (*GetPoolsResponse, error)
+	GetPool(ctx context.Context, in *GetPoolRequest, opts ...grpc.CallOption) (*Pool, error)
+	GetPoolPerformance(ctx context.Context, in *GetPoolPerformanceRequest, opts ...grpc.CallOption) (*GetPoolPerformanceResponse, error)
+	GetPoolBlocks(ctx context.Context, in *PoolPageRequest, opts ...grpc.CallOption) (*BlocksPage, error)
+	GetPoolPayments(ctx context.Context, in *PoolPageRequest, opts ...grpc.CallOption) (*PaymentsPage, error)
+	GetMiners(ctx context.Context, in *PoolPageRequest, opts ...grpc.CallOption) (*GetMinersResponse, error)
+	GetMiner(ctx context.Context, in *GetMinerRequest, opts ...grpc.CallOption) (*Miner, error)
+	GetMinerPayments(ctx context.Context, in *MinerPageRequest, opts ...grpc.CallOption) (*PaymentsPage, error)
+	GetMinerBalanceChanges(ctx context.Context, in *MinerPageRequest, opts ...grpc.CallOption) (*BalanceChangesPage, error)
+	GetMinerDailyEarnings(ctx context.Context, in *MinerPageRequest, opts ...grpc.CallOption) (*DailyEarningsPage, error)
+	GetMinerPerformance(ctx context.Context, in *GetMinerPerformanceRequest, opts ...grpc.CallOption) (*GetMinerPerformanceResponse, error)
+	// Subscribe streams pool notifications. Empty filters match everything. Notifications are
+	// only sent if the server is wired to a notification source.
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Miningcore_SubscribeClient, error)
+}
+
+type miningcoreClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMiningcoreClient(cc grpc.ClientConnInterface) MiningcoreClient {
+	return &miningcoreClient{cc}
+}
+
+func (c *miningcoreClient) GetPools(ctx context.Context, in *GetPoolsRequest, opts ...grpc.CallOption) (*GetPoolsResponse, error) {
+	out := new(GetPoolsResponse)
+	err := c.cc.Invoke(ctx, Miningcore_GetPools_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) GetPool(ctx context.Context, in *GetPoolRequest, opts ...grpc.CallOption) (*Pool, error) {
+	out := new(Pool)
+	err := c.cc.Invoke(ctx, Miningcore_GetPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) GetPoolPerformance(ctx context.Context, in *GetPoolPerformanceRequest, opts ...grpc.CallOption) (*GetPoolPerformanceResponse, error) {
+	out := new(GetPoolPerformanceResponse)
+	err := c.cc.Invoke(ctx, Miningcore_GetPoolPerformance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) GetPoolBlocks(ctx context.Context, in *PoolPageRequest, opts ...grpc.CallOption) (*BlocksPage, error) {
+	out := new(BlocksPage)
+	err := c.cc.Invoke(ctx, Miningcore_GetPoolBlocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) GetPoolPayments(ctx context.Context, in *PoolPageRequest, opts ...grpc.CallOption) (*PaymentsPage, error) {
+	out := new(PaymentsPage)
+	err := c.cc.Invoke(ctx, Miningcore_GetPoolPayments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) GetMiners(ctx context.Context, in *PoolPageRequest, opts ...grpc.CallOption) (*GetMinersResponse, error) {
+	out := new(GetMinersResponse)
+	err := c.cc.Invoke(ctx, Miningcore_GetMiners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) GetMiner(ctx context.Context, in *GetMinerRequest, opts ...grpc.CallOption) (*Miner, error) {
+	out := new(Miner)
+	err := c.cc.Invoke(ctx, Miningcore_GetMiner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) GetMinerPayments(ctx context.Context, in *MinerPageRequest, opts ...grpc.CallOption) (*PaymentsPage, error) {
+	out := new(PaymentsPage)
+	err := c.cc.Invoke(ctx, Miningcore_GetMinerPayments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) GetMinerBalanceChanges(ctx context.Context, in *MinerPageRequest, opts ...grpc.CallOption) (*BalanceChangesPage, error) {
+	out := new(BalanceChangesPage)
+	err := c.cc.Invoke(ctx, Miningcore_GetMinerBalanceChanges_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) GetMinerDailyEarnings(ctx context.Context, in *MinerPageRequest, opts ...grpc.CallOption) (*DailyEarningsPage, error) {
+	out := new(DailyEarningsPage)
+	err := c.cc.Invoke(ctx, Miningcore_GetMinerDailyEarnings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) GetMinerPerformance(ctx context.Context, in *GetMinerPerformanceRequest, opts ...grpc.CallOption) (*GetMinerPerformanceResponse, error) {
+	out := new(GetMinerPerformanceResponse)
+	err := c.cc.Invoke(ctx, Miningcore_GetMinerPerformance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *miningcoreClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Miningcore_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Miningcore_ServiceDesc.Streams[0], Miningcore_Subscribe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &miningcoreSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Miningcore_SubscribeClient interface {
+	Recv() (*Notification, error)
+	grpc.ClientStream
+}
+
+type miningcoreSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *miningcoreSubscribeClient) Recv() (*Notification, error) {
+	m := new(Notification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MiningcoreServer is the server API for Miningcore service.
+// All implementations must embed UnimplementedMiningcoreServer
+// for forward compatibility
+type MiningcoreServer interface {
+	GetPools(context.Context, *GetPoolsRequest) (*GetPoolsResponse, error)
+	GetPool(context.Context, *GetPoolRequest) (*Pool, error)
+	GetPoolPerformance(context.Context, *GetPoolPerformanceRequest) (*GetPoolPerformanceResponse, error)
+	GetPoolBlocks(context.Context, *PoolPageRequest) (*BlocksPage, error)
+	GetPoolPayments(context.Context, *PoolPageRequest) (*PaymentsPage, error)
+	GetMiners(context.Context, *PoolPageRequest) (*GetMinersResponse, error)
+	GetMiner(context.Context, *GetMinerRequest) (*Miner, error)
+	GetMinerPayments(context.Context, *MinerPageRequest) (*PaymentsPage, error)
+	GetMinerBalanceChanges(context.Context, *MinerPageRequest) (*BalanceChangesPage, error)
+	GetMinerDailyEarnings(context.Context, *MinerPageRequest) (*DailyEarningsPage, error)
+	GetMinerPerformance(context.Context, *GetMinerPerformanceRequest) (*GetMinerPerformanceResponse, error)
+	// Subscribe streams pool notifications. Empty filters match everything. Notifications are
+	// only sent if the server is wired to a notification source.
+	Subscribe(*SubscribeRequest, Miningcore_SubscribeServer) error
+	mustEmbedUnimplementedMiningcoreServer()
+}
+
+// UnimplementedMiningcoreServer must be embedded to have forward compatible implementations.
+type UnimplementedMiningcoreServer struct {
+}
+
+func (UnimplementedMiningcoreServer) GetPools(context.Context, *GetPoolsRequest) (*GetPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPools not implemented")
+}
+func (UnimplementedMiningcoreServer) GetPool(context.Context, *GetPoolRequest) (*Pool, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPool not implemented")
+}
+func (UnimplementedMiningcoreServer) GetPoolPerformance(context.Context, *GetPoolPerformanceRequest) (*GetPoolPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolPerformance not implemented")
+}
+func (UnimplementedMiningcoreServer) GetPoolBlocks(context.Context, *PoolPageRequest) (*BlocksPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolBlocks not implemented")
+}
+func (UnimplementedMiningcoreServer) GetPoolPayments(context.Context, *PoolPageRequest) (*PaymentsPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPoolPayments not implemented")
+}
+func (UnimplementedMiningcoreServer) GetMiners(context.Context, *PoolPageRequest) (*GetMinersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMiners not implemented")
+}
+func (UnimplementedMiningcoreServer) GetMiner(context.Context, *GetMinerRequest) (*Miner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMiner not implemented")
+}
+func (UnimplementedMiningcoreServer) GetMinerPayments(context.Context, *MinerPageRequest) (*PaymentsPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMinerPayments not implemented")
+}
+func (UnimplementedMiningcoreServer) GetMinerBalanceChanges(context.Context, *MinerPageRequest) (*BalanceChangesPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMinerBalanceChanges not implemented")
+}
+func (UnimplementedMiningcoreServer) GetMinerDailyEarnings(context.Context, *MinerPageRequest) (*DailyEarningsPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMinerDailyEarnings not implemented")
+}
+func (UnimplementedMiningcoreServer) GetMinerPerformance(context.Context, *GetMinerPerformanceRequest) (*GetMinerPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMinerPerformance not implemented")
+}
+func (UnimplementedMiningcoreServer) Subscribe(*SubscribeRequest, Miningcore_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedMiningcoreServer) mustEmbedUnimplementedMiningcoreServer() {}
+
+// UnsafeMiningcoreServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MiningcoreServer will
+// result in compilation errors.
+type UnsafeMiningcoreServer interface {
+	mustEmbedUnimplementedMiningcoreServer()
+}
+
+func RegisterMiningcoreServer(s grpc.ServiceRegistrar, srv MiningcoreServer) {
+	s.RegisterService(&Miningcore_ServiceDesc, srv)
+}
+
+func _Miningcore_GetPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetPools_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetPools(ctx, req.(*GetPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_GetPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetPool(ctx, req.(*GetPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_GetPoolPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPoolPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetPoolPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetPoolPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetPoolPerformance(ctx, req.(*GetPoolPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_GetPoolBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetPoolBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetPoolBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetPoolBlocks(ctx, req.(*PoolPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_GetPoolPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetPoolPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetPoolPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetPoolPayments(ctx, req.(*PoolPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_GetMiners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetMiners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetMiners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetMiners(ctx, req.(*PoolPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_GetMiner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMinerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetMiner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetMiner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetMiner(ctx, req.(*GetMinerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_GetMinerPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinerPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetMinerPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetMinerPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetMinerPayments(ctx, req.(*MinerPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_GetMinerBalanceChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinerPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetMinerBalanceChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetMinerBalanceChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetMinerBalanceChanges(ctx, req.(*MinerPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_GetMinerDailyEarnings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinerPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetMinerDailyEarnings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetMinerDailyEarnings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetMinerDailyEarnings(ctx, req.(*MinerPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_GetMinerPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMinerPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MiningcoreServer).GetMinerPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Miningcore_GetMinerPerformance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MiningcoreServer).GetMinerPerformance(ctx, req.(*GetMinerPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Miningcore_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MiningcoreServer).Subscribe(m, &miningcoreSubscribeServer{stream})
+}
+
+type Miningcore_SubscribeServer interface {
+	Send(*Notification) error
+	grpc.ServerStream
+}
+
+type miningcoreSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *miningcoreSubscribeServer) Send(m *Notification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Miningcore_ServiceDesc is the grpc.ServiceDesc for Miningcore service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Miningcore_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "miningcore.v1.Miningcore",
+	HandlerType: (*MiningcoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPools",
+			Handler:    _Miningcore_GetPools_Handler,
+		},
+		{
+			MethodName: "GetPool",
+			Handler:    _Miningcore_GetPool_Handler,
+		},
+		{
+			MethodName: "GetPoolPerformance",
+			Handler:    _Miningcore_GetPoolPerformance_Handler,
+		},
+		{
+			MethodName: "GetPoolBlocks",
+			Handler:    _Miningcore_GetPoolBlocks_Handler,
+		},
+		{
+			MethodName: "GetPoolPayments",
+			Handler:    _Miningcore_GetPoolPayments_Handler,
+		},
+		{
+			MethodName: "GetMiners",
+			Handler:    _Miningcore_GetMiners_Handler,
+		},
+		{
+			MethodName: "GetMiner",
+			Handler:    _Miningcore_GetMiner_Handler,
+		},
+		{
+			MethodName: "GetMinerPayments",
+			Handler:    _Miningcore_GetMinerPayments_Handler,
+		},
+		{
+			MethodName: "GetMinerBalanceChanges",
+			Handler:    _Miningcore_GetMinerBalanceChanges_Handler,
+		},
+		{
+			MethodName: "GetMinerDailyEarnings",
+			Handler:    _Miningcore_GetMinerDailyEarnings_Handler,
+		},
+		{
+			MethodName: "GetMinerPerformance",
+			Handler:    _Miningcore_GetMinerPerformance_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Miningcore_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "miningcore.proto",
+}
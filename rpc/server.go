@@ -0,0 +1,317 @@
+// Package rpc exposes the miningcore api as a gRPC service. The protobuf definitions are in
+// miningcore.proto, the generated code is in the pb package.
+package rpc
+
+//go:generate buf generate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/rpc/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ServerOpts are options for the server.
+type ServerOpts func(*Server)
+
+// WithSubscriberBuffer sets the number of notifications buffered per subscriber.
+// Notifications for subscribers with a full buffer are dropped.
+func WithSubscriberBuffer(n int) ServerOpts {
+	return func(s *Server) {
+		s.buffer = n
+	}
+}
+
+// Source produces notifications and passes them to publish until the context is canceled,
+// e.g. the Run method of a feed.Feed or a reader of the websocket notification stream.
+type Source func(ctx context.Context, publish func(miningcore.Notification)) error
+
+// WithSource sets the source of the notifications that Run streams to the subscribers.
+func WithSource(src Source) ServerOpts {
+	return func(s *Server) {
+		s.source = src
+	}
+}
+
+// Server implements the Miningcore gRPC service with the client.
+// Notifications passed to Publish are streamed to all matching subscribers. The server has
+// no notifications of its own: the caller either sets a source with WithSource and calls Run,
+// or passes the notifications to Publish itself. Otherwise Subscribe streams nothing.
+type Server struct {
+	pb.UnimplementedMiningcoreServer
+
+	client *miningcore.Client
+	buffer int
+	source Source
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	pools map[string]bool
+	types map[string]bool
+	ch    chan *pb.Notification
+}
+
+// NewServer creates a new server.
+func NewServer(c *miningcore.Client, opts ...ServerOpts) *Server {
+	s := &Server{
+		client:      c,
+		buffer:      64,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Register registers the server with a gRPC server.
+func (s *Server) Register(r grpc.ServiceRegistrar) {
+	pb.RegisterMiningcoreServer(r, s)
+}
+
+// ErrNoSource is returned by Run if the server has no notification source.
+var ErrNoSource = errors.New("no notification source")
+
+// Run publishes the notifications of the source until the context is canceled or the source fails.
+func (s *Server) Run(ctx context.Context) error {
+	if s.source == nil {
+		return ErrNoSource
+	}
+	return s.source(ctx, s.Publish)
+}
+
+// Publish sends a notification to all subscribers whose filters match it.
+// Nil and unknown notifications are ignored.
+func (s *Server) Publish(n miningcore.Notification) {
+	msg, pool := notification(n)
+	if msg == nil {
+		return
+	}
+	typ := string(n.MessageType())
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for sub := range s.subscribers {
+		if len(sub.pools) > 0 && !sub.pools[pool] {
+			continue
+		}
+		if len(sub.types) > 0 && !sub.types[typ] {
+			continue
+		}
+		select {
+		case sub.ch <- msg:
+		default:
+		}
+	}
+}
+
+// Subscribe implements pb.MiningcoreServer.
+func (s *Server) Subscribe(req *pb.SubscribeRequest, stream pb.Miningcore_SubscribeServer) error {
+	sub := &subscriber{
+		pools: set(req.PoolIds),
+		types: set(req.Types),
+		ch:    make(chan *pb.Notification, s.buffer),
+	}
+	s.mu.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.subscribers, sub)
+		s.mu.Unlock()
+	}()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg := <-sub.ch:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetPools implements pb.MiningcoreServer.
+func (s *Server) GetPools(ctx context.Context, _ *pb.GetPoolsRequest) (*pb.GetPoolsResponse, error) {
+	pools, code, err := s.client.GetPools(ctx)
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	res := &pb.GetPoolsResponse{Pools: make([]*pb.Pool, 0, len(pools))}
+	for _, p := range pools {
+		res.Pools = append(res.Pools, pool(p))
+	}
+	return res, nil
+}
+
+// GetPool implements pb.MiningcoreServer.
+func (s *Server) GetPool(ctx context.Context, req *pb.GetPoolRequest) (*pb.Pool, error) {
+	p, code, err := s.client.GetPool(ctx, req.PoolId)
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	return pool(p), nil
+}
+
+// GetPoolPerformance implements pb.MiningcoreServer.
+func (s *Server) GetPoolPerformance(ctx context.Context, req *pb.GetPoolPerformanceRequest) (*pb.GetPoolPerformanceResponse, error) {
+	params := make(map[string]string)
+	if req.Range != "" {
+		params["r"] = req.Range
+	}
+	if req.Interval != "" {
+		params["i"] = req.Interval
+	}
+	stats, code, err := s.client.GetPerformance(ctx, req.PoolId, params)
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	return &pb.GetPoolPerformanceResponse{Stats: convert(stats, poolPerformance)}, nil
+}
+
+// GetPoolBlocks implements pb.MiningcoreServer.
+func (s *Server) GetPoolBlocks(ctx context.Context, req *pb.PoolPageRequest) (*pb.BlocksPage, error) {
+	res, code, err := s.client.GetPoolBlocks(ctx, req.PoolId, pageParams(req.Page, req.PageSize))
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	return &pb.BlocksPage{Blocks: convert(res.Result, block), PageCount: pageCount(res.Meta)}, nil
+}
+
+// GetPoolPayments implements pb.MiningcoreServer.
+func (s *Server) GetPoolPayments(ctx context.Context, req *pb.PoolPageRequest) (*pb.PaymentsPage, error) {
+	res, code, err := s.client.GetPoolPayments(ctx, req.PoolId, pageParams(req.Page, req.PageSize))
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	return &pb.PaymentsPage{Payments: convert(res.Result, payment), PageCount: pageCount(res.Meta)}, nil
+}
+
+// GetMiners implements pb.MiningcoreServer.
+func (s *Server) GetMiners(ctx context.Context, req *pb.PoolPageRequest) (*pb.GetMinersResponse, error) {
+	miners, code, err := s.client.GetMiners(ctx, req.PoolId, pageParams(req.Page, req.PageSize))
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	return &pb.GetMinersResponse{Miners: convert(miners, minerPerformance)}, nil
+}
+
+// GetMiner implements pb.MiningcoreServer.
+func (s *Server) GetMiner(ctx context.Context, req *pb.GetMinerRequest) (*pb.Miner, error) {
+	var params map[string]string
+	if req.PerfMode != "" {
+		params = map[string]string{"perfMode": req.PerfMode}
+	}
+	m, code, err := s.client.GetMiner(ctx, req.PoolId, req.Address, params)
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	return miner(m), nil
+}
+
+// GetMinerPayments implements pb.MiningcoreServer.
+func (s *Server) GetMinerPayments(ctx context.Context, req *pb.MinerPageRequest) (*pb.PaymentsPage, error) {
+	res, code, err := s.client.GetMinerPayments(ctx, req.PoolId, req.Address, pageParams(req.Page, req.PageSize))
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	return &pb.PaymentsPage{Payments: convert(res.Result, payment), PageCount: pageCount(res.Meta)}, nil
+}
+
+// GetMinerBalanceChanges implements pb.MiningcoreServer.
+func (s *Server) GetMinerBalanceChanges(ctx context.Context, req *pb.MinerPageRequest) (*pb.BalanceChangesPage, error) {
+	res, code, err := s.client.GetMinerBalanceChanges(ctx, req.PoolId, req.Address, pageParams(req.Page, req.PageSize))
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	return &pb.BalanceChangesPage{BalanceChanges: convert(res.Result, balanceChange), PageCount: pageCount(res.Meta)}, nil
+}
+
+// GetMinerDailyEarnings implements pb.MiningcoreServer.
+func (s *Server) GetMinerDailyEarnings(ctx context.Context, req *pb.MinerPageRequest) (*pb.DailyEarningsPage, error) {
+	res, code, err := s.client.GetMinerDailyEarnings(ctx, req.PoolId, req.Address, pageParams(req.Page, req.PageSize))
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	return &pb.DailyEarningsPage{Earnings: convert(res.Result, dailyEarning), PageCount: pageCount(res.Meta)}, nil
+}
+
+// GetMinerPerformance implements pb.MiningcoreServer.
+func (s *Server) GetMinerPerformance(ctx context.Context, req *pb.GetMinerPerformanceRequest) (*pb.GetMinerPerformanceResponse, error) {
+	var params map[string]string
+	if req.Mode != "" {
+		params = map[string]string{"mode": req.Mode}
+	}
+	samples, code, err := s.client.GetMinerPerformance(ctx, req.PoolId, req.Address, params)
+	if err != nil {
+		return nil, toStatus(ctx, code, err)
+	}
+	return &pb.GetMinerPerformanceResponse{Samples: convert(samples, workerStats)}, nil
+}
+
+// toStatus maps a client error and the http status of the response to a gRPC status.
+func toStatus(ctx context.Context, code int, err error) error {
+	switch {
+	case errors.Is(err, context.Canceled) || ctx.Err() == context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded) || ctx.Err() == context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	switch code {
+	case 0:
+		return status.Error(codes.Unavailable, err.Error())
+	case http.StatusOK:
+		return status.Error(codes.Internal, err.Error())
+	case http.StatusBadRequest:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case http.StatusUnauthorized:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case http.StatusForbidden:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case http.StatusNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case http.StatusTooManyRequests:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Unknown, err.Error())
+}
+
+func pageParams(page, size int32) map[string]string {
+	params := map[string]string{"page": strconv.Itoa(int(page))}
+	if size > 0 {
+		params["pageSize"] = strconv.Itoa(int(size))
+	}
+	return params
+}
+
+func pageCount(m *miningcore.Meta) int64 {
+	if m == nil {
+		return 0
+	}
+	return m.PageCount
+}
+
+func set(values []string) map[string]bool {
+	if len(values) == 0 {
+		return nil
+	}
+	res := make(map[string]bool, len(values))
+	for _, v := range values {
+		res[v] = true
+	}
+	return res
+}
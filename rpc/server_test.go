@@ -0,0 +1,153 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/rpc/pb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newTestClient(t *testing.T, s *Server) pb.MiningcoreClient {
+	lis := bufconn.Listen(1 << 20)
+	gs := grpc.NewServer()
+	s.Register(gs)
+	go gs.Serve(lis)
+	t.Cleanup(gs.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewMiningcoreClient(conn)
+}
+
+func TestServer(t *testing.T) {
+	pools, err := os.ReadFile("../testdata/pools.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pageParams map[string]string
+	var mode string
+	var perfParams map[string]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/pools":
+			w.Write(pools)
+		case "/api/pools/eth/performance":
+			perfParams = map[string]string{"r": r.URL.Query().Get("r"), "i": r.URL.Query().Get("i")}
+			w.Write([]byte(`{"stats":[{"created":"2022-07-01T00:00:00Z","poolHashrate":100}]}`))
+		case "/api/pools/eth/miners/0xabc/performance":
+			mode = r.URL.Query().Get("mode")
+			w.Write([]byte(`[{"created":"2022-07-01T00:00:00Z","workers":{"rig1":{"hashrate":100}}}]`))
+		case "/api/v2/pools/eth/blocks":
+			pageParams = map[string]string{"page": r.URL.Query().Get("page"), "pageSize": r.URL.Query().Get("pageSize")}
+			json.NewEncoder(w).Encode(miningcore.BlocksRes{
+				Meta:   &miningcore.Meta{PageCount: 3, Success: true},
+				Result: []*miningcore.Block{{PoolID: "eth", BlockHeight: 100, Status: miningcore.BlockPending}},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte("not found"))
+		}
+	}))
+	defer ts.Close()
+
+	c := newTestClient(t, NewServer(miningcore.New(ts.URL)))
+	ctx := context.Background()
+
+	res, err := c.GetPools(ctx, &pb.GetPoolsRequest{})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res.Pools)
+	assert.Equal(t, "eth", res.Pools[0].Id)
+	assert.Equal(t, "ethereum", res.Pools[0].Coin.Family)
+	assert.Equal(t, float64(4), res.Pools[0].Ports["420"].Difficulty)
+
+	blocks, err := c.GetPoolBlocks(ctx, &pb.PoolPageRequest{PoolId: "eth", Page: 1, PageSize: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), blocks.PageCount)
+	assert.Equal(t, int64(100), blocks.Blocks[0].BlockHeight)
+	assert.Equal(t, "pending", blocks.Blocks[0].Status)
+	assert.Equal(t, map[string]string{"page": "1", "pageSize": "10"}, pageParams)
+
+	poolPerf, err := c.GetPoolPerformance(ctx, &pb.GetPoolPerformanceRequest{PoolId: "eth", Range: "Month", Interval: "Day"})
+	assert.NoError(t, err)
+	assert.Len(t, poolPerf.Stats, 1)
+	assert.Equal(t, map[string]string{"r": "Month", "i": "Day"}, perfParams)
+
+	perf, err := c.GetMinerPerformance(ctx, &pb.GetMinerPerformanceRequest{PoolId: "eth", Address: "0xabc", Mode: "Month"})
+	assert.NoError(t, err)
+	assert.Len(t, perf.Samples, 1)
+	assert.Equal(t, "Month", mode)
+
+	_, err = c.GetPool(ctx, &pb.GetPoolRequest{PoolId: "btc"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestSubscribe(t *testing.T) {
+	s := NewServer(miningcore.New("http://localhost"))
+	c := newTestClient(t, s)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := c.Subscribe(ctx, &pb.SubscribeRequest{PoolIds: []string{"eth"}, Types: []string{"blockfound", "payment"}})
+	assert.NoError(t, err)
+	// wait for the subscription to be registered
+	for {
+		s.mu.Lock()
+		n := len(s.subscribers)
+		s.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	s.Publish(nil)
+	s.Publish((*miningcore.BlockFoundMessage)(nil))
+	s.Publish(&miningcore.BlockFoundMessage{BlockMessage: miningcore.BlockMessage{PoolID: "btc", BlockHeight: 1}})
+	s.Publish(&miningcore.ChainHeightMessage{BlockMessage: miningcore.BlockMessage{PoolID: "eth", BlockHeight: 2}})
+	s.Publish(&miningcore.BlockFoundMessage{BlockMessage: miningcore.BlockMessage{PoolID: "eth", BlockHeight: 3}, Miner: "0xabc"})
+	s.Publish(miningcore.PaymentMessage{PoolID: "eth", Amount: 1.5, TxIDs: []string{"tx"}})
+
+	msg, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), msg.GetBlockFound().Block.BlockHeight)
+	assert.Equal(t, "0xabc", msg.GetBlockFound().Miner)
+
+	msg, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, msg.GetPayment().Amount)
+	assert.Equal(t, []string{"tx"}, msg.GetPayment().TxIds)
+}
+
+func TestRunSource(t *testing.T) {
+	assert.ErrorIs(t, NewServer(nil).Run(context.Background()), ErrNoSource)
+
+	s := NewServer(nil, WithSource(func(ctx context.Context, publish func(miningcore.Notification)) error {
+		publish(&miningcore.ChainHeightMessage{BlockMessage: miningcore.BlockMessage{PoolID: "eth", BlockHeight: 1}})
+		return errors.New("source closed")
+	}))
+	sub := &subscriber{ch: make(chan *pb.Notification, 1)}
+	s.subscribers[sub] = struct{}{}
+	assert.EqualError(t, s.Run(context.Background()), "source closed")
+	msg := <-sub.ch
+	assert.Equal(t, uint64(1), msg.GetChainHeight().Block.BlockHeight)
+}
@@ -0,0 +1,538 @@
+// Package store keeps a local, file based copy of the history of miningcore pools.
+// Sync only pulls records that are newer than the stored ones and the query methods
+// mirror the getters of the miningcore client.
+package store
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+const (
+	kindBlock         = "block"
+	kindPoolPayment   = "poolpayment"
+	kindMinerPayment  = "minerpayment"
+	kindBalanceChange = "balancechange"
+	kindMinerPerf     = "minerperformance"
+	kindPoolPerf      = "poolperformance"
+	defaultPageSize   = 15
+)
+
+// record is a single line of the store file. Later records replace earlier ones with the same key.
+type record struct {
+	Kind    string          `json:"kind"`
+	PoolID  string          `json:"poolId"`
+	Address string          `json:"address,omitempty"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// SyncStats are the number of new or updated records of a sync.
+type SyncStats struct {
+	Blocks           int `json:"blocks"`
+	PoolPayments     int `json:"poolPayments"`
+	PoolPerformance  int `json:"poolPerformance"`
+	MinerPayments    int `json:"minerPayments"`
+	BalanceChanges   int `json:"balanceChanges"`
+	MinerPerformance int `json:"minerPerformance"`
+}
+
+// Store is a local history of pools and miners backed by an append-only JSON Lines file.
+type Store struct {
+	mu   sync.RWMutex
+	file *os.File
+	enc  *json.Encoder
+
+	blocks         map[string]*series[*miningcore.Block]
+	poolPayments   map[string]*series[*miningcore.Payment]
+	poolPerf       map[string]*series[*miningcore.PoolPerformance]
+	minerPayments  map[string]*series[*miningcore.Payment]
+	balanceChanges map[string]*series[*miningcore.BalanceChange]
+	minerPerf      map[string]*series[*miningcore.WorkerStats]
+
+	now func() time.Time
+}
+
+// Open opens or creates the store in the given directory.
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(filepath.Join(dir, "history.jsonl"), os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	s := &Store{
+		file:           f,
+		enc:            json.NewEncoder(f),
+		blocks:         make(map[string]*series[*miningcore.Block]),
+		poolPayments:   make(map[string]*series[*miningcore.Payment]),
+		poolPerf:       make(map[string]*series[*miningcore.PoolPerformance]),
+		minerPayments:  make(map[string]*series[*miningcore.Payment]),
+		balanceChanges: make(map[string]*series[*miningcore.BalanceChange]),
+		minerPerf:      make(map[string]*series[*miningcore.WorkerStats]),
+		now:            time.Now,
+	}
+	if err := s.load(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Close closes the store file.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+func (s *Store) load() error {
+	scanner := bufio.NewScanner(s.file)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		var r record
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		if err := s.apply(r); err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return scanner.Err()
+}
+
+func (s *Store) apply(r record) error {
+	minerKey := r.PoolID + "/" + r.Address
+	switch r.Kind {
+	case kindBlock:
+		return put(s.blocks, r.PoolID, r.Data, blockKey, blockTime)
+	case kindPoolPayment:
+		return put(s.poolPayments, r.PoolID, r.Data, paymentKey, paymentTime)
+	case kindPoolPerf:
+		return put(s.poolPerf, r.PoolID, r.Data, poolPerfKey, poolPerfTime)
+	case kindMinerPayment:
+		return put(s.minerPayments, minerKey, r.Data, paymentKey, paymentTime)
+	case kindBalanceChange:
+		return put(s.balanceChanges, minerKey, r.Data, balanceChangeKey, balanceChangeTime)
+	case kindMinerPerf:
+		return put(s.minerPerf, minerKey, r.Data, minerPerfKey, minerPerfTime)
+	}
+	return fmt.Errorf("unknown record kind %q", r.Kind)
+}
+
+// append writes records to the file and applies them. The caller must hold the write lock.
+func (s *Store) append(kind, id, addr string, items ...any) error {
+	for _, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		r := record{Kind: kind, PoolID: id, Address: addr, Data: data}
+		if err := s.enc.Encode(r); err != nil {
+			return err
+		}
+		if err := s.apply(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sync pulls everything that is new since the last sync for a pool and the given miner addresses.
+// Blocks are pulled again from the oldest pending block on, so their status stays current.
+// The records are fetched without holding the lock, queries are only blocked while they are merged.
+func (s *Store) Sync(ctx context.Context, c *miningcore.Client, id string, addrs ...string) (SyncStats, error) {
+	type minerSince struct {
+		payments, balanceChanges, perf time.Time
+	}
+	s.mu.RLock()
+	blocksSince := oldestPending(s.blocks[id])
+	if blocksSince.IsZero() {
+		blocksSince = s.blocks[id].newest()
+	}
+	paymentsSince, perfSince := s.poolPayments[id].newest(), s.poolPerf[id].newest()
+	miners := make([]minerSince, len(addrs))
+	for i, addr := range addrs {
+		key := id + "/" + addr
+		miners[i] = minerSince{s.minerPayments[key].newest(), s.balanceChanges[key].newest(), s.minerPerf[key].newest()}
+	}
+	s.mu.RUnlock()
+
+	var stats SyncStats
+	blocks, err := fetchPaged(ctx, c.PoolBlocksPages(id), blockTime, blocksSince, true)
+	if err != nil {
+		return stats, err
+	}
+	payments, err := fetchPaged(ctx, c.PoolPaymentsPages(id), paymentTime, paymentsSince, false)
+	if err != nil {
+		return stats, err
+	}
+	all, _, err := c.GetPerformance(ctx, id)
+	if err != nil {
+		return stats, err
+	}
+	perf, err := newer(all, poolPerfTime, perfSince)
+	if err != nil {
+		return stats, err
+	}
+	type minerRecords struct {
+		payments       []*miningcore.Payment
+		balanceChanges []*miningcore.BalanceChange
+		perf           []*miningcore.WorkerStats
+	}
+	records := make([]minerRecords, len(addrs))
+	for i, addr := range addrs {
+		r := &records[i]
+		if r.payments, err = fetchPaged(ctx, c.MinerPaymentsPages(id, addr), paymentTime, miners[i].payments, false); err != nil {
+			return stats, err
+		}
+		if r.balanceChanges, err = fetchPaged(ctx, c.MinerBalanceChangesPages(id, addr), balanceChangeTime, miners[i].balanceChanges, false); err != nil {
+			return stats, err
+		}
+		samples, _, err := c.GetMinerPerformance(ctx, id, addr)
+		if err != nil {
+			return stats, err
+		}
+		if r.perf, err = newer(samples, minerPerfTime, miners[i].perf); err != nil {
+			return stats, err
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stats.Blocks, err = merge(s, kindBlock, id, "", s.blocks, blocks); err != nil {
+		return stats, err
+	}
+	if stats.PoolPayments, err = merge(s, kindPoolPayment, id, "", s.poolPayments, payments); err != nil {
+		return stats, err
+	}
+	if stats.PoolPerformance, err = merge(s, kindPoolPerf, id, "", s.poolPerf, perf); err != nil {
+		return stats, err
+	}
+	for i, addr := range addrs {
+		n, err := merge(s, kindMinerPayment, id, addr, s.minerPayments, records[i].payments)
+		if err != nil {
+			return stats, err
+		}
+		stats.MinerPayments += n
+		if n, err = merge(s, kindBalanceChange, id, addr, s.balanceChanges, records[i].balanceChanges); err != nil {
+			return stats, err
+		}
+		stats.BalanceChanges += n
+		if n, err = merge(s, kindMinerPerf, id, addr, s.minerPerf, records[i].perf); err != nil {
+			return stats, err
+		}
+		stats.MinerPerformance += n
+	}
+	return stats, s.file.Sync()
+}
+
+// fetchPaged returns all items newer than since, oldest first. With inclusive set,
+// items created at since are returned as well.
+func fetchPaged[T any](ctx context.Context, fetch miningcore.PageFunc[T], created func(T) (time.Time, error), since time.Time, inclusive bool) ([]T, error) {
+	var items []T
+	err := miningcore.Paginate(ctx, 0, fetch, func(item T) (bool, error) {
+		t, err := created(item)
+		if err != nil {
+			return false, err
+		}
+		if t.Before(since) || !inclusive && t.Equal(since) {
+			return false, nil
+		}
+		items = append(items, item)
+		return true, nil
+	})
+	return reverse(items), err
+}
+
+// newer returns the items created after since.
+func newer[T any](all []T, created func(T) (time.Time, error), since time.Time) ([]T, error) {
+	var items []T
+	for _, item := range all {
+		t, err := created(item)
+		if err != nil {
+			return nil, err
+		}
+		if t.After(since) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// merge appends the items that are not stored in the same version yet. The caller must hold the write lock.
+func merge[T any](s *Store, kind, id, addr string, m map[string]*series[T], items []T) (int, error) {
+	key := id
+	if addr != "" {
+		key += "/" + addr
+	}
+	var changed []any
+	for _, item := range items {
+		if !m[key].contains(item) {
+			changed = append(changed, item)
+		}
+	}
+	return len(changed), s.append(kind, id, addr, changed...)
+}
+
+// GetPoolBlocks returns the stored blocks of a pool, newest first.
+// It supports the `page` and `pageSize` parameters like the API.
+func (s *Store) GetPoolBlocks(ctx context.Context, id string, params ...map[string]string) (*miningcore.BlocksRes, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	items, meta := page(s.blocks[id].sorted(), params...)
+	return &miningcore.BlocksRes{Meta: meta, Result: items}, http.StatusOK, nil
+}
+
+// GetPoolPayments returns the stored payments of a pool, newest first.
+// It supports the `page` and `pageSize` parameters like the API.
+func (s *Store) GetPoolPayments(ctx context.Context, id string, params ...map[string]string) (*miningcore.PaymentRes, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	items, meta := page(s.poolPayments[id].sorted(), params...)
+	return &miningcore.PaymentRes{Meta: meta, Result: items}, http.StatusOK, nil
+}
+
+// GetMinerPayments returns the stored payments of a miner, newest first.
+// It supports the `page` and `pageSize` parameters like the API.
+func (s *Store) GetMinerPayments(ctx context.Context, id, addr string, params ...map[string]string) (*miningcore.PaymentRes, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	items, meta := page(s.minerPayments[id+"/"+addr].sorted(), params...)
+	return &miningcore.PaymentRes{Meta: meta, Result: items}, http.StatusOK, nil
+}
+
+// GetMinerBalanceChanges returns the stored balance changes of a miner, newest first.
+// It supports the `page` and `pageSize` parameters like the API.
+func (s *Store) GetMinerBalanceChanges(ctx context.Context, id, addr string, params ...map[string]string) (*miningcore.BalanceChangeRes, int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	items, meta := page(s.balanceChanges[id+"/"+addr].sorted(), params...)
+	return &miningcore.BalanceChangeRes{Meta: meta, Result: items}, http.StatusOK, nil
+}
+
+// GetMinerPerformance returns the stored performance samples of a miner, oldest first.
+// It supports the `mode` parameter (Hour, Day or Month) like the API, without it all samples are returned.
+func (s *Store) GetMinerPerformance(ctx context.Context, id, addr string, params ...map[string]string) ([]*miningcore.WorkerStats, int, error) {
+	from, err := s.rangeStart(params, "mode")
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return reverse(after(s.minerPerf[id+"/"+addr].sorted(), minerPerfTime, from)), http.StatusOK, nil
+}
+
+// GetPerformance returns the stored performance samples of a pool, oldest first.
+// It supports the `r` parameter (Hour, Day or Month) like the API, without it all samples are returned.
+// The samples keep the interval they were synced with.
+func (s *Store) GetPerformance(ctx context.Context, id string, params ...map[string]string) ([]*miningcore.PoolPerformance, int, error) {
+	from, err := s.rangeStart(params, "r")
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return reverse(after(s.poolPerf[id].sorted(), poolPerfTime, from)), http.StatusOK, nil
+}
+
+// performanceRanges are the sample ranges of the performance endpoints.
+var performanceRanges = map[string]time.Duration{
+	"Hour":  time.Hour,
+	"Day":   24 * time.Hour,
+	"Month": 30 * 24 * time.Hour,
+}
+
+// rangeStart returns the start of the sample range given by the named parameter, zero if it is not set.
+func (s *Store) rangeStart(params []map[string]string, name string) (time.Time, error) {
+	if len(params) == 0 || params[0][name] == "" {
+		return time.Time{}, nil
+	}
+	d, ok := performanceRanges[params[0][name]]
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid %s %q", name, params[0][name])
+	}
+	return s.now().Add(-d), nil
+}
+
+// after cuts items sorted newest first at the first one created before from.
+func after[T any](items []T, created func(T) (time.Time, error), from time.Time) []T {
+	for i, item := range items {
+		if t, err := created(item); err == nil && t.Before(from) {
+			return items[:i]
+		}
+	}
+	return items
+}
+
+func page[T any](items []T, params ...map[string]string) ([]T, *miningcore.Meta) {
+	p, size := 0, defaultPageSize
+	if len(params) > 0 {
+		if v, err := strconv.Atoi(params[0]["page"]); err == nil && v >= 0 {
+			p = v
+		}
+		if v, err := strconv.Atoi(params[0]["pageSize"]); err == nil && v > 0 {
+			size = v
+		}
+	}
+	meta := &miningcore.Meta{Success: true, PageCount: int64((len(items) + size - 1) / size)}
+	start := p * size
+	if start >= len(items) {
+		return []T{}, meta
+	}
+	end := start + size
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[start:end], meta
+}
+
+func reverse[T any](items []T) []T {
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+	return items
+}
+
+func oldestPending(s *series[*miningcore.Block]) time.Time {
+	var oldest time.Time
+	if s == nil {
+		return oldest
+	}
+	for key, b := range s.items {
+		if b.Status == miningcore.BlockPending && (oldest.IsZero() || s.created[key].Before(oldest)) {
+			oldest = s.created[key]
+		}
+	}
+	return oldest
+}
+
+// series is a set of records keyed by identity that can be listed by creation time.
+type series[T any] struct {
+	items   map[string]T
+	created map[string]time.Time
+	key     func(T) string
+	data    map[string]string
+}
+
+func put[T any](m map[string]*series[T], id string, data json.RawMessage, key func(T) string, created func(T) (time.Time, error)) error {
+	var item T
+	if err := json.Unmarshal(data, &item); err != nil {
+		return err
+	}
+	t, err := created(item)
+	if err != nil {
+		return err
+	}
+	s, ok := m[id]
+	if !ok {
+		s = &series[T]{items: make(map[string]T), created: make(map[string]time.Time), data: make(map[string]string), key: key}
+		m[id] = s
+	}
+	k := key(item)
+	s.items[k] = item
+	s.created[k] = t
+	s.data[k] = string(data)
+	return nil
+}
+
+// contains reports whether the exact same version of the item is stored.
+func (s *series[T]) contains(item T) bool {
+	if s == nil {
+		return false
+	}
+	data, err := json.Marshal(item)
+	if err != nil {
+		return false
+	}
+	return s.data[s.key(item)] == string(data)
+}
+
+func (s *series[T]) newest() time.Time {
+	var newest time.Time
+	if s == nil {
+		return newest
+	}
+	for _, t := range s.created {
+		if t.After(newest) {
+			newest = t
+		}
+	}
+	return newest
+}
+
+// sorted returns the items newest first.
+func (s *series[T]) sorted() []T {
+	if s == nil {
+		return []T{}
+	}
+	keys := make([]string, 0, len(s.items))
+	for k := range s.items {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		ti, tj := s.created[keys[i]], s.created[keys[j]]
+		if ti.Equal(tj) {
+			return keys[i] > keys[j]
+		}
+		return ti.After(tj)
+	})
+	res := make([]T, len(keys))
+	for i, k := range keys {
+		res[i] = s.items[k]
+	}
+	return res
+}
+
+func blockKey(b *miningcore.Block) string {
+	return fmt.Sprintf("%d/%s", b.BlockHeight, b.Created)
+}
+
+func blockTime(b *miningcore.Block) (time.Time, error) {
+	return miningcore.ParseTime(b.Created)
+}
+
+func paymentKey(p *miningcore.Payment) string {
+	return fmt.Sprintf("%s/%s/%s/%g", p.Created, p.TransactionConfirmationData, p.Address, p.Amount)
+}
+
+func paymentTime(p *miningcore.Payment) (time.Time, error) {
+	return miningcore.ParseTime(p.Created)
+}
+
+func balanceChangeKey(c *miningcore.BalanceChange) string {
+	return fmt.Sprintf("%s/%g/%s", c.Created, c.Amount, c.Usage)
+}
+
+func balanceChangeTime(c *miningcore.BalanceChange) (time.Time, error) {
+	return miningcore.ParseTime(c.Created)
+}
+
+func poolPerfKey(p *miningcore.PoolPerformance) string {
+	return p.Created
+}
+
+func poolPerfTime(p *miningcore.PoolPerformance) (time.Time, error) {
+	return miningcore.ParseTime(p.Created)
+}
+
+func minerPerfKey(w *miningcore.WorkerStats) string {
+	return w.Created
+}
+
+func minerPerfTime(w *miningcore.WorkerStats) (time.Time, error) {
+	return miningcore.ParseTime(w.Created)
+}
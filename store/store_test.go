@@ -0,0 +1,153 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakePool struct {
+	mu       sync.Mutex
+	blocks   []*miningcore.Block
+	payments []*miningcore.Payment
+	samples  []*miningcore.WorkerStats
+}
+
+func (p *fakePool) handler() http.Handler {
+	mux := http.NewServeMux()
+	write := func(w http.ResponseWriter, r *http.Request, v any) {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		json.NewEncoder(w).Encode(v)
+	}
+	firstPage := func(r *http.Request) bool { return r.URL.Query().Get("page") == "0" }
+	mux.HandleFunc("/api/v2/pools/eth/blocks", func(w http.ResponseWriter, r *http.Request) {
+		res := miningcore.BlocksRes{}
+		if firstPage(r) {
+			res.Result = p.blocks
+		}
+		write(w, r, res)
+	})
+	mux.HandleFunc("/api/v2/pools/eth/payments", func(w http.ResponseWriter, r *http.Request) {
+		res := miningcore.PaymentRes{}
+		if firstPage(r) {
+			res.Result = p.payments
+		}
+		write(w, r, res)
+	})
+	mux.HandleFunc("/api/v2/pools/eth/miners/0xabc/payments", func(w http.ResponseWriter, r *http.Request) {
+		write(w, r, miningcore.PaymentRes{})
+	})
+	mux.HandleFunc("/api/v2/pools/eth/miners/0xabc/balancechanges", func(w http.ResponseWriter, r *http.Request) {
+		write(w, r, miningcore.BalanceChangeRes{})
+	})
+	mux.HandleFunc("/api/pools/eth/miners/0xabc/performance", func(w http.ResponseWriter, r *http.Request) {
+		write(w, r, p.samples)
+	})
+	mux.HandleFunc("/api/pools/eth/performance", func(w http.ResponseWriter, r *http.Request) {
+		write(w, r, map[string]any{"stats": []*miningcore.PoolPerformance{}})
+	})
+	return mux
+}
+
+func TestSync(t *testing.T) {
+	pool := &fakePool{
+		blocks: []*miningcore.Block{
+			{BlockHeight: 2, Status: miningcore.BlockPending, Created: "2022-07-02T00:00:00Z"},
+			{BlockHeight: 1, Status: miningcore.BlockConfirmed, Created: "2022-07-01T00:00:00Z"},
+		},
+		payments: []*miningcore.Payment{
+			{Address: "0xabc", Amount: 1, Created: "2022-07-01T00:00:00Z"},
+		},
+		samples: []*miningcore.WorkerStats{
+			{Created: "2022-07-01T00:00:00Z", Workers: map[string]*miningcore.WorkerPerformanceStats{"rig1": {Hashrate: 10}}},
+		},
+	}
+	ts := httptest.NewServer(pool.handler())
+	defer ts.Close()
+	client := miningcore.New(ts.URL)
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := Open(dir)
+	assert.NoError(t, err)
+	stats, err := s.Sync(ctx, client, "eth", "0xabc")
+	assert.NoError(t, err)
+	assert.Equal(t, SyncStats{Blocks: 2, PoolPayments: 1, MinerPerformance: 1}, stats)
+
+	stats, err = s.Sync(ctx, client, "eth", "0xabc")
+	assert.NoError(t, err)
+	assert.Equal(t, SyncStats{}, stats)
+
+	pool.mu.Lock()
+	pool.blocks[0].Status = miningcore.BlockConfirmed
+	pool.blocks = append([]*miningcore.Block{{BlockHeight: 3, Status: miningcore.BlockPending, Created: "2022-07-03T00:00:00Z"}}, pool.blocks...)
+	pool.samples = append(pool.samples, &miningcore.WorkerStats{Created: "2022-07-01T01:00:00Z"})
+	pool.mu.Unlock()
+	stats, err = s.Sync(ctx, client, "eth", "0xabc")
+	assert.NoError(t, err)
+	assert.Equal(t, SyncStats{Blocks: 2, MinerPerformance: 1}, stats)
+	assert.NoError(t, s.Close())
+
+	s, err = Open(dir)
+	assert.NoError(t, err)
+	defer s.Close()
+	blocks, code, err := s.GetPoolBlocks(ctx, "eth", map[string]string{"page": "0", "pageSize": "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, int64(2), blocks.PageCount)
+	assert.Len(t, blocks.Result, 2)
+	assert.Equal(t, int64(3), blocks.Result[0].BlockHeight)
+	assert.Equal(t, miningcore.BlockConfirmed, blocks.Result[1].Status)
+
+	samples, _, err := s.GetMinerPerformance(ctx, "eth", "0xabc")
+	assert.NoError(t, err)
+	assert.Len(t, samples, 2)
+	assert.Equal(t, "2022-07-01T00:00:00Z", samples[0].Created)
+
+	s.now = func() time.Time { return time.Date(2022, 7, 1, 1, 30, 0, 0, time.UTC) }
+	samples, _, err = s.GetMinerPerformance(ctx, "eth", "0xabc", map[string]string{"mode": "Hour"})
+	assert.NoError(t, err)
+	assert.Len(t, samples, 1)
+	assert.Equal(t, "2022-07-01T01:00:00Z", samples[0].Created)
+	_, code, err = s.GetMinerPerformance(ctx, "eth", "0xabc", map[string]string{"mode": "Year"})
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	payments, _, err := s.GetPoolPayments(ctx, "eth")
+	assert.NoError(t, err)
+	assert.Len(t, payments.Result, 1)
+}
+
+func TestSyncDoesNotBlockQueries(t *testing.T) {
+	reached, release := make(chan struct{}), make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(reached)
+		<-release
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer ts.Close()
+
+	s, err := Open(t.TempDir())
+	assert.NoError(t, err)
+	defer s.Close()
+	done := make(chan error)
+	go func() {
+		_, err := s.Sync(context.Background(), miningcore.New(ts.URL), "eth")
+		done <- err
+	}()
+
+	<-reached
+	_, code, err := s.GetPoolBlocks(context.Background(), "eth")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	close(release)
+	assert.Error(t, <-done)
+}
@@ -0,0 +1,355 @@
+// Package stratum implements a minimal stratum v1 client that subscribes, authorizes and
+// receives jobs and difficulty updates. Submitting work is not supported.
+package stratum
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned for requests on a closed connection.
+var ErrClosed = errors.New("stratum connection closed")
+
+// Error is an error returned by the stratum server.
+type Error struct {
+	Code    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("stratum error %d: %s", e.Code, e.Message)
+}
+
+// UnmarshalJSON accepts both the [code, message, traceback] array of stratum v1 and the
+// {"code": ..., "message": ...} object of JSON-RPC 2.0 servers.
+func (e *Error) UnmarshalJSON(b []byte) error {
+	var arr []json.RawMessage
+	if err := json.Unmarshal(b, &arr); err == nil {
+		if len(arr) > 0 {
+			json.Unmarshal(arr[0], &e.Code)
+		}
+		if len(arr) > 1 {
+			json.Unmarshal(arr[1], &e.Message)
+		}
+		return nil
+	}
+	var obj struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return err
+	}
+	e.Code, e.Message = obj.Code, obj.Message
+	return nil
+}
+
+// Subscription is the result of mining.subscribe. ExtraNonce1 and ExtraNonce2Size are only
+// set for servers that answer in the bitcoin format.
+type Subscription struct {
+	ExtraNonce1     string
+	ExtraNonce2Size int
+	Result          json.RawMessage
+}
+
+// Job is a mining.notify notification. Params are the raw parameters, their layout depends
+// on the coin family.
+type Job struct {
+	ID        string
+	CleanJobs bool
+	Params    []json.RawMessage
+	Received  time.Time
+}
+
+// Notification is a message the server sent without a request, the parsed mining.notify and
+// mining.set_difficulty notifications are also available through Job and Difficulty.
+type Notification struct {
+	Method string
+	Params []json.RawMessage
+}
+
+type request struct {
+	ID     uint64 `json:"id"`
+	Method string `json:"method"`
+	Params any    `json:"params"`
+}
+
+type message struct {
+	ID     *uint64           `json:"id"`
+	Method string            `json:"method"`
+	Params []json.RawMessage `json:"params"`
+	Result json.RawMessage   `json:"result"`
+	Error  *Error            `json:"error"`
+}
+
+// ClientOpts are options for the client.
+type ClientOpts func(*Client)
+
+// WithTLS connects using TLS with the given config.
+func WithTLS(cfg *tls.Config) ClientOpts {
+	return func(c *Client) {
+		c.tls = cfg
+	}
+}
+
+// WithUserAgent sets the user agent sent with mining.subscribe.
+func WithUserAgent(ua string) ClientOpts {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithSubscribeParams overrides the parameters of mining.subscribe, e.g.
+// ["agent", "EthereumStratum/1.0.0"] for ethereum pools.
+func WithSubscribeParams(params ...any) ClientOpts {
+	return func(c *Client) {
+		c.subscribeParams = params
+	}
+}
+
+// WithNotificationHandler sets a function that is called for every notification.
+// It is called from the read loop and must not block.
+func WithNotificationHandler(fn func(Notification)) ClientOpts {
+	return func(c *Client) {
+		c.onNotification = fn
+	}
+}
+
+// Client is a stratum v1 connection.
+type Client struct {
+	conn            net.Conn
+	tls             *tls.Config
+	userAgent       string
+	subscribeParams []any
+	onNotification  func(Notification)
+
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	nextID  uint64
+	pending map[uint64]chan *message
+	err     error
+	done    chan struct{}
+
+	difficulty float64
+	job        *Job
+	jobs       chan struct{}
+}
+
+// Dial connects to a stratum server.
+func Dial(ctx context.Context, addr string, opts ...ClientOpts) (*Client, error) {
+	c := &Client{
+		userAgent: "go-miningcore-client/1.0",
+		pending:   make(map[uint64]chan *message),
+		done:      make(chan struct{}),
+		jobs:      make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	if c.tls != nil {
+		tlsConn := tls.Client(conn, c.tls)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+	c.conn = conn
+	go c.read()
+	return c, nil
+}
+
+// Close closes the connection.
+func (c *Client) Close() error {
+	err := c.conn.Close()
+	<-c.done
+	return err
+}
+
+// Subscribe sends mining.subscribe.
+func (c *Client) Subscribe(ctx context.Context) (*Subscription, error) {
+	params := c.subscribeParams
+	if params == nil {
+		params = []any{c.userAgent}
+	}
+	res, err := c.Call(ctx, "mining.subscribe", params)
+	if err != nil {
+		return nil, err
+	}
+	s := &Subscription{Result: res}
+	// bitcoin: [[subscriptions...], extranonce1, extranonce2_size]
+	var arr []json.RawMessage
+	if json.Unmarshal(res, &arr) == nil && len(arr) == 3 {
+		json.Unmarshal(arr[1], &s.ExtraNonce1)
+		json.Unmarshal(arr[2], &s.ExtraNonce2Size)
+	}
+	return s, nil
+}
+
+// Authorize sends mining.authorize for a worker, usually address.workername.
+func (c *Client) Authorize(ctx context.Context, worker, password string) error {
+	res, err := c.Call(ctx, "mining.authorize", []string{worker, password})
+	if err != nil {
+		return err
+	}
+	var ok bool
+	if err := json.Unmarshal(res, &ok); err != nil || !ok {
+		return fmt.Errorf("authorization of %s rejected", worker)
+	}
+	return nil
+}
+
+// Call sends a request and waits for its response.
+func (c *Client) Call(ctx context.Context, method string, params any) (json.RawMessage, error) {
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return nil, c.err
+	}
+	c.nextID++
+	id := c.nextID
+	ch := make(chan *message, 1)
+	c.pending[id] = ch
+	c.mu.Unlock()
+	defer func() {
+		c.mu.Lock()
+		delete(c.pending, id)
+		c.mu.Unlock()
+	}()
+
+	b, err := json.Marshal(request{ID: id, Method: method, Params: params})
+	if err != nil {
+		return nil, err
+	}
+	c.writeMu.Lock()
+	_, err = c.conn.Write(append(b, '\n'))
+	c.writeMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.done:
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return nil, c.err
+	case m := <-ch:
+		if m.Error != nil {
+			return nil, m.Error
+		}
+		return m.Result, nil
+	}
+}
+
+// Difficulty returns the last difficulty set by the server.
+func (c *Client) Difficulty() float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.difficulty
+}
+
+// Job returns the last job sent by the server or nil.
+func (c *Client) Job() *Job {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.job
+}
+
+// WaitJob waits until the server sent a job.
+func (c *Client) WaitJob(ctx context.Context) (*Job, error) {
+	for {
+		c.mu.Lock()
+		job, jobs, err := c.job, c.jobs, c.err
+		c.mu.Unlock()
+		if job != nil {
+			return job, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-c.done:
+		case <-jobs:
+		}
+	}
+}
+
+func (c *Client) read() {
+	defer close(c.done)
+	sc := bufio.NewScanner(c.conn)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	var err error
+	for sc.Scan() {
+		var m message
+		if err = json.Unmarshal(sc.Bytes(), &m); err != nil {
+			err = fmt.Errorf("invalid stratum message: %w", err)
+			c.conn.Close()
+			break
+		}
+		c.handle(&m)
+	}
+	if err == nil {
+		err = sc.Err()
+	}
+	if err == nil || errors.Is(err, net.ErrClosed) {
+		err = ErrClosed
+	}
+	c.mu.Lock()
+	c.err = err
+	c.mu.Unlock()
+}
+
+func (c *Client) handle(m *message) {
+	if m.Method == "" {
+		if m.ID == nil {
+			return
+		}
+		c.mu.Lock()
+		ch, ok := c.pending[*m.ID]
+		c.mu.Unlock()
+		if ok {
+			ch <- m
+		}
+		return
+	}
+
+	switch m.Method {
+	case "mining.set_difficulty":
+		var diff float64
+		if len(m.Params) > 0 && json.Unmarshal(m.Params[0], &diff) == nil {
+			c.mu.Lock()
+			c.difficulty = diff
+			c.mu.Unlock()
+		}
+	case "mining.notify":
+		job := &Job{Params: m.Params, Received: time.Now()}
+		if len(m.Params) > 0 {
+			json.Unmarshal(m.Params[0], &job.ID)
+			json.Unmarshal(m.Params[len(m.Params)-1], &job.CleanJobs)
+		}
+		c.mu.Lock()
+		c.job = job
+		close(c.jobs)
+		c.jobs = make(chan struct{})
+		c.mu.Unlock()
+	}
+	if c.onNotification != nil {
+		c.onNotification(Notification{Method: m.Method, Params: m.Params})
+	}
+}
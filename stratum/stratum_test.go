@@ -0,0 +1,147 @@
+package stratum
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeServer accepts workers starting with "good" and sends a difficulty and a job after authorization.
+func fakeServer(t *testing.T) (net.Listener, string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				sc := bufio.NewScanner(conn)
+				for sc.Scan() {
+					var req struct {
+						ID     uint64   `json:"id"`
+						Method string   `json:"method"`
+						Params []string `json:"params"`
+					}
+					if json.Unmarshal(sc.Bytes(), &req) != nil {
+						return
+					}
+					id := strconv.FormatUint(req.ID, 10)
+					switch req.Method {
+					case "mining.subscribe":
+						conn.Write([]byte(`{"id":` + id + `,"result":[[["mining.set_difficulty","1"],["mining.notify","1"]],"08000002",4],"error":null}` + "\n"))
+					case "mining.authorize":
+						ok := strings.HasPrefix(req.Params[0], "good")
+						conn.Write([]byte(`{"id":` + id + `,"result":` + strconv.FormatBool(ok) + `,"error":null}` + "\n"))
+						if ok {
+							conn.Write([]byte(`{"id":null,"method":"mining.set_difficulty","params":[16]}` + "\n"))
+							conn.Write([]byte(`{"id":null,"method":"mining.notify","params":["1f","prevhash","cb1","cb2",[],"20000000","1d00ffff","62c3f6a0",true]}` + "\n"))
+						}
+					default:
+						conn.Write([]byte(`{"id":` + id + `,"result":null,"error":[20,"unsupported method",null]}` + "\n"))
+					}
+				}
+			}(conn)
+		}
+	}()
+	return ln, strconv.Itoa(ln.Addr().(*net.TCPAddr).Port)
+}
+
+func TestClient(t *testing.T) {
+	ln, port := fakeServer(t)
+	defer ln.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var notifications int32
+	c, err := Dial(ctx, "127.0.0.1:"+port, WithNotificationHandler(func(Notification) {
+		atomic.AddInt32(&notifications, 1)
+	}))
+	assert.NoError(t, err)
+
+	s, err := c.Subscribe(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "08000002", s.ExtraNonce1)
+	assert.Equal(t, 4, s.ExtraNonce2Size)
+
+	assert.Error(t, c.Authorize(ctx, "bad.rig", "x"))
+	assert.NoError(t, c.Authorize(ctx, "good.rig", "x"))
+	job, err := c.WaitJob(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "1f", job.ID)
+	assert.True(t, job.CleanJobs)
+	assert.Len(t, job.Params, 9)
+	assert.Equal(t, float64(16), c.Difficulty())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&notifications))
+
+	_, err = c.Call(ctx, "mining.submit", []string{"good.rig"})
+	assert.Equal(t, &Error{Code: 20, Message: "unsupported method"}, err)
+
+	assert.NoError(t, c.Close())
+	_, err = c.Call(ctx, "mining.subscribe", nil)
+	assert.ErrorIs(t, err, ErrClosed)
+}
+
+func TestVerify(t *testing.T) {
+	ln, port := fakeServer(t)
+	defer ln.Close()
+
+	var polls int32
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/miners") {
+			var miners []*miningcore.MinerPerformanceStats
+			if atomic.AddInt32(&polls, 1) > 1 {
+				miners = append(miners, &miningcore.MinerPerformanceStats{Miner: "goodaddr"})
+			}
+			json.NewEncoder(w).Encode(miners)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]any{"pool": miningcore.PoolInfo{
+			ID:    "btc",
+			Ports: map[string]miningcore.PoolEndpoint{port: {}},
+		}})
+	}))
+	defer api.Close()
+	listPollInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	c := miningcore.New(api.URL)
+	v, err := Verify(ctx, c, Target{Pool: "btc", Host: "127.0.0.1", Port: port, Address: "goodaddr", Worker: "goodaddr.probe"})
+	assert.NoError(t, err)
+	assert.True(t, v.Authorized)
+	assert.True(t, v.Listed)
+	assert.Equal(t, float64(16), v.Difficulty)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&polls))
+
+	v, err = Verify(ctx, c, Target{Pool: "btc", Host: "127.0.0.1", Port: port, Address: "badaddr"})
+	assert.Error(t, err)
+	assert.False(t, v.Authorized)
+
+	_, err = Verify(ctx, c, Target{Pool: "btc", Port: "1"})
+	assert.ErrorContains(t, err, "has no port 1")
+
+	xmr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"pool": miningcore.PoolInfo{
+			ID:    "xmr",
+			Coin:  &miningcore.APICoinConfig{Family: miningcore.FamilyCryptonote},
+			Ports: map[string]miningcore.PoolEndpoint{port: {}},
+		}})
+	}))
+	defer xmr.Close()
+	_, err = Verify(ctx, miningcore.New(xmr.URL), Target{Pool: "xmr", Host: "127.0.0.1", Port: port, Address: "goodaddr"})
+	assert.ErrorIs(t, err, ErrUnsupportedFamily)
+}
@@ -0,0 +1,109 @@
+package stratum
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// Target is an address to verify on a stratum port of a pool.
+// Host defaults to localhost, Worker to the address itself.
+type Target struct {
+	Pool     string
+	Host     string
+	Port     string
+	Address  string
+	Worker   string
+	Password string
+}
+
+// Verification is the result of Verify.
+type Verification struct {
+	Subscription *Subscription
+	Authorized   bool
+	Difficulty   float64
+	Job          *Job
+	Listed       bool
+}
+
+// ErrUnsupportedFamily is returned by Verify for pools of a coin family whose stratum
+// protocol the client doesn't speak.
+var ErrUnsupportedFamily = errors.New("unsupported coin family")
+
+// listPollInterval is the interval GetMiners is polled at while waiting for the address.
+var listPollInterval = 10 * time.Second
+
+// Verify connects to a port of a pool, subscribes, authorizes the target address and waits for
+// the first job. It then polls the miners of the pool until the address is listed or the context
+// is done. Miningcore only lists miners with recent shares, so the address must be mining on the
+// pool for Listed to become true. TLS is used if the port is configured for it, certificates of
+// TLSAuto ports are self-signed and not verified.
+//
+// Only pools speaking mining.subscribe and mining.authorize are supported, cryptonote pools use
+// login and job instead and return ErrUnsupportedFamily.
+func Verify(ctx context.Context, api *miningcore.Client, t Target, opts ...ClientOpts) (*Verification, error) {
+	pool, _, err := api.GetPool(ctx, t.Pool)
+	if err != nil {
+		return nil, err
+	}
+	if pool.Coin != nil && pool.Coin.Family == miningcore.FamilyCryptonote {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFamily, pool.Coin.Family)
+	}
+	endpoint, ok := pool.Ports[t.Port]
+	if !ok {
+		return nil, fmt.Errorf("pool %s has no port %s", t.Pool, t.Port)
+	}
+	host := t.Host
+	if host == "" {
+		host = "localhost"
+	}
+	if endpoint.TLS {
+		opts = append([]ClientOpts{WithTLS(&tls.Config{ServerName: host, InsecureSkipVerify: endpoint.TLSAuto})}, opts...)
+	}
+	worker := t.Worker
+	if worker == "" {
+		worker = t.Address
+	}
+
+	c, err := Dial(ctx, net.JoinHostPort(host, t.Port), opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer c.Close()
+
+	v := &Verification{}
+	if v.Subscription, err = c.Subscribe(ctx); err != nil {
+		return v, fmt.Errorf("subscribe: %w", err)
+	}
+	if err := c.Authorize(ctx, worker, t.Password); err != nil {
+		return v, err
+	}
+	v.Authorized = true
+	if v.Job, err = c.WaitJob(ctx); err != nil {
+		return v, fmt.Errorf("wait for job: %w", err)
+	}
+	v.Difficulty = c.Difficulty()
+
+	tick := time.NewTicker(listPollInterval)
+	defer tick.Stop()
+	for {
+		err := miningcore.Paginate(ctx, 0, api.MinersPages(t.Pool), func(m *miningcore.MinerPerformanceStats) (bool, error) {
+			v.Listed = strings.EqualFold(m.Miner, t.Address)
+			return !v.Listed, nil
+		})
+		if err != nil || v.Listed {
+			return v, err
+		}
+		select {
+		case <-ctx.Done():
+			return v, fmt.Errorf("address not listed: %w", ctx.Err())
+		case <-tick.C:
+		}
+	}
+}
@@ -0,0 +1,327 @@
+// Package tracker follows blocks found by a pool from their first sighting until
+// they are confirmed or orphaned.
+package tracker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/analytics"
+	"github.com/stratumfarm/go-miningcore-client/internal/blocks"
+	"github.com/stratumfarm/go-miningcore-client/internal/poll"
+)
+
+// Transition is emitted when a tracked block leaves the pending state.
+// Maturity is the time from the creation of the block (or its first sighting if the creation
+// time is unknown) until the transition.
+type Transition struct {
+	PoolID      string                 `json:"poolId"`
+	BlockHeight uint64                 `json:"blockHeight"`
+	Hash        string                 `json:"hash"`
+	From        miningcore.BlockStatus `json:"from"`
+	To          miningcore.BlockStatus `json:"to"`
+	Reward      float64                `json:"reward"`
+	Created     time.Time              `json:"created"`
+	FirstSeen   time.Time              `json:"firstSeen"`
+	At          time.Time              `json:"at"`
+	Maturity    time.Duration          `json:"maturity"`
+}
+
+// Record is the tracked state of a block.
+type Record struct {
+	PoolID      string                 `json:"poolId"`
+	BlockHeight uint64                 `json:"blockHeight"`
+	Hash        string                 `json:"hash"`
+	Status      miningcore.BlockStatus `json:"status"`
+	Progress    float64                `json:"progress"`
+	Reward      float64                `json:"reward"`
+	Created     time.Time              `json:"created"`
+	FirstSeen   time.Time              `json:"firstSeen"`
+	Updated     time.Time              `json:"updated"`
+	Finalized   time.Time              `json:"finalized"`
+}
+
+// Timing summarizes the finalized blocks of a pool.
+type Timing struct {
+	Confirmed      int           `json:"confirmed"`
+	Orphaned       int           `json:"orphaned"`
+	Pending        int           `json:"pending"`
+	AverageConfirm time.Duration `json:"averageConfirm"`
+	MedianConfirm  time.Duration `json:"medianConfirm"`
+	MaxConfirm     time.Duration `json:"maxConfirm"`
+}
+
+// TrackerOpts are options for the tracker.
+type TrackerOpts func(*Tracker)
+
+// WithInterval sets the poll interval.
+func WithInterval(d time.Duration) TrackerOpts {
+	return func(t *Tracker) {
+		t.interval = d
+	}
+}
+
+// WithRetention sets how long finalized blocks are kept for the timing stats.
+func WithRetention(d time.Duration) TrackerOpts {
+	return func(t *Tracker) {
+		t.retention = d
+	}
+}
+
+// WithErrorHandler sets a function that is called with errors of failed polls in Run.
+func WithErrorHandler(fn func(error)) TrackerOpts {
+	return func(t *Tracker) {
+		t.onError = fn
+	}
+}
+
+// Tracker follows the blocks of a list of pools. It is fed by polling the block history
+// and optionally by notifications from the websocket stream or the feed package.
+type Tracker struct {
+	client    *miningcore.Client
+	pools     []string
+	interval  time.Duration
+	retention time.Duration
+	onError   func(error)
+	now       func() time.Time
+
+	mu     sync.Mutex
+	blocks map[string]*Record
+}
+
+// New creates a new tracker for the given pool ids.
+func New(c *miningcore.Client, pools []string, opts ...TrackerOpts) *Tracker {
+	t := &Tracker{
+		client:    c,
+		pools:     pools,
+		interval:  time.Minute,
+		retention: 7 * 24 * time.Hour,
+		now:       time.Now,
+		blocks:    make(map[string]*Record),
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// Run polls all pools until the context is canceled and calls fn for every transition.
+func (t *Tracker) Run(ctx context.Context, fn func(Transition)) error {
+	return poll.Each(ctx, t.interval, t.Poll, fn, t.onError)
+}
+
+// Poll fetches the latest blocks of all pools once and returns the resulting transitions.
+// Pending blocks are followed beyond the first page of blocks until they are unlocked.
+func (t *Tracker) Poll(ctx context.Context) ([]Transition, error) {
+	var res []Transition
+	var firstErr error
+	for _, id := range t.pools {
+		fetched, err := blocks.Follow(ctx, t.client.PoolBlocksPages(id), t.pending(id), func(b *miningcore.Block) string {
+			return recordKey(id, uint64(b.BlockHeight))
+		})
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		for _, b := range fetched {
+			if tr, ok := t.observeBlock(id, b); ok {
+				res = append(res, tr)
+			}
+		}
+	}
+	t.prune()
+	return res, firstErr
+}
+
+// pending returns the keys of the pending blocks of a pool with their creation time.
+func (t *Tracker) pending(poolID string) map[string]time.Time {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	res := make(map[string]time.Time)
+	for key, r := range t.blocks {
+		if r.PoolID == poolID && r.Status == miningcore.BlockPending {
+			res[key] = start(r)
+		}
+	}
+	return res
+}
+
+// Handle applies a notification to the tracked blocks. BlockFoundMessage, BlockUnlockProgressMessage
+// and BlockUnlockedMessage are used as values or pointers, all other notifications are ignored.
+func (t *Tracker) Handle(n miningcore.Notification) []Transition {
+	switch m := n.(type) {
+	case miningcore.BlockFoundMessage:
+		return t.Handle(&m)
+	case miningcore.BlockUnlockProgressMessage:
+		return t.Handle(&m)
+	case miningcore.BlockUnlockedMessage:
+		return t.Handle(&m)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	switch m := n.(type) {
+	case *miningcore.BlockFoundMessage:
+		t.record(m.PoolID, m.BlockHeight, now)
+	case *miningcore.BlockUnlockProgressMessage:
+		r := t.record(m.PoolID, m.BlockHeight, now)
+		if r.Status == miningcore.BlockPending {
+			r.Progress = m.Progress
+			r.Updated = now
+		}
+	case *miningcore.BlockUnlockedMessage:
+		r := t.record(m.PoolID, m.BlockHeight, now)
+		if m.BlockHash != "" {
+			r.Hash = m.BlockHash
+		}
+		r.Reward = m.Reward
+		status := miningcore.BlockConfirmed
+		if m.BlockType == "orphan" {
+			status = miningcore.BlockOrphaned
+		}
+		if tr, ok := t.transition(r, status, now); ok {
+			return []Transition{tr}
+		}
+	}
+	return nil
+}
+
+// Blocks returns a snapshot of all tracked blocks, newest first.
+func (t *Tracker) Blocks() []Record {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	res := make([]Record, 0, len(t.blocks))
+	for _, r := range t.blocks {
+		res = append(res, *r)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].BlockHeight > res[j].BlockHeight })
+	return res
+}
+
+// Timing returns the maturity statistics of the tracked blocks of a pool.
+func (t *Tracker) Timing(poolID string) Timing {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var res Timing
+	var durations []time.Duration
+	for _, r := range t.blocks {
+		if r.PoolID != poolID {
+			continue
+		}
+		switch r.Status {
+		case miningcore.BlockConfirmed:
+			res.Confirmed++
+			if !r.Finalized.IsZero() {
+				durations = append(durations, r.Finalized.Sub(start(r)))
+			}
+		case miningcore.BlockOrphaned:
+			res.Orphaned++
+		default:
+			res.Pending++
+		}
+	}
+	d := analytics.Summarize(durations)
+	res.AverageConfirm = d.Mean
+	res.MedianConfirm = d.Median
+	res.MaxConfirm = d.Max
+	return res
+}
+
+func (t *Tracker) observeBlock(poolID string, b *miningcore.Block) (Transition, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	key := recordKey(poolID, uint64(b.BlockHeight))
+	_, known := t.blocks[key]
+	r := t.record(poolID, uint64(b.BlockHeight), now)
+	if created, err := miningcore.ParseTime(b.Created); err == nil {
+		r.Created = created
+	}
+	if b.Hash != "" {
+		r.Hash = b.Hash
+	}
+	r.Reward = b.Reward
+	if b.Status == miningcore.BlockPending {
+		if r.Status == miningcore.BlockPending && r.Progress != b.ConfirmationProgress {
+			r.Progress = b.ConfirmationProgress
+			r.Updated = now
+		}
+		return Transition{}, false
+	}
+	if !known {
+		// blocks that are already final when first seen have no observed maturity
+		r.Status = b.Status
+		return Transition{}, false
+	}
+	return t.transition(r, b.Status, now)
+}
+
+func (t *Tracker) record(poolID string, height uint64, now time.Time) *Record {
+	key := recordKey(poolID, height)
+	r, ok := t.blocks[key]
+	if !ok {
+		r = &Record{
+			PoolID:      poolID,
+			BlockHeight: height,
+			Status:      miningcore.BlockPending,
+			FirstSeen:   now,
+			Updated:     now,
+		}
+		t.blocks[key] = r
+	}
+	return r
+}
+
+func (t *Tracker) transition(r *Record, to miningcore.BlockStatus, now time.Time) (Transition, bool) {
+	if r.Status == to {
+		return Transition{}, false
+	}
+	from := r.Status
+	r.Status = to
+	r.Updated = now
+	r.Finalized = now
+	if to == miningcore.BlockConfirmed {
+		r.Progress = 1
+	}
+	return Transition{
+		PoolID:      r.PoolID,
+		BlockHeight: r.BlockHeight,
+		Hash:        r.Hash,
+		From:        from,
+		To:          to,
+		Reward:      r.Reward,
+		Created:     r.Created,
+		FirstSeen:   r.FirstSeen,
+		At:          now,
+		Maturity:    now.Sub(start(r)),
+	}, true
+}
+
+func (t *Tracker) prune() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	limit := t.now().Add(-t.retention)
+	for key, r := range t.blocks {
+		if r.Status != miningcore.BlockPending && r.Updated.Before(limit) {
+			delete(t.blocks, key)
+		}
+	}
+}
+
+func recordKey(poolID string, height uint64) string {
+	return fmt.Sprintf("%s/%d", poolID, height)
+}
+
+func start(r *Record) time.Time {
+	if !r.Created.IsZero() {
+		return r.Created
+	}
+	return r.FirstSeen
+}
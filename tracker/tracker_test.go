@@ -0,0 +1,119 @@
+package tracker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/internal/blocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracker(t *testing.T) {
+	var mu sync.Mutex
+	blocks := []*miningcore.Block{
+		{BlockHeight: 100, Status: miningcore.BlockPending, Created: "2022-07-01T00:00:00Z"},
+		{BlockHeight: 90, Status: miningcore.BlockConfirmed, Created: "2022-06-30T00:00:00Z"},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(miningcore.BlocksRes{Result: blocks})
+	}))
+	defer ts.Close()
+
+	now := time.Date(2022, 7, 1, 0, 5, 0, 0, time.UTC)
+	tr := New(miningcore.New(ts.URL), []string{"eth"})
+	tr.now = func() time.Time { return now }
+
+	transitions, err := tr.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, transitions)
+
+	assert.Empty(t, tr.Handle(&miningcore.BlockUnlockProgressMessage{
+		BlockMessage: miningcore.BlockMessage{PoolID: "eth", BlockHeight: 100},
+		Progress:     0.5,
+	}))
+	assert.Equal(t, 0.5, tr.Blocks()[0].Progress)
+
+	now = now.Add(time.Hour)
+	mu.Lock()
+	blocks[0].Status = miningcore.BlockConfirmed
+	blocks[0].Reward = 2
+	mu.Unlock()
+	transitions, err = tr.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, transitions, 1)
+	assert.Equal(t, miningcore.BlockPending, transitions[0].From)
+	assert.Equal(t, miningcore.BlockConfirmed, transitions[0].To)
+	assert.Equal(t, time.Hour+5*time.Minute, transitions[0].Maturity)
+
+	transitions = tr.Handle(&miningcore.BlockUnlockedMessage{
+		BlockMessage: miningcore.BlockMessage{PoolID: "eth", BlockHeight: 101},
+		BlockType:    "orphan",
+	})
+	assert.Len(t, transitions, 1)
+	assert.Equal(t, miningcore.BlockOrphaned, transitions[0].To)
+
+	timing := tr.Timing("eth")
+	assert.Equal(t, 2, timing.Confirmed)
+	assert.Equal(t, 1, timing.Orphaned)
+	assert.Equal(t, time.Hour+5*time.Minute, timing.AverageConfirm)
+
+	// value messages are handled like pointers
+	assert.Empty(t, tr.Handle(miningcore.BlockFoundMessage{BlockMessage: miningcore.BlockMessage{PoolID: "eth", BlockHeight: 102}}))
+	now = now.Add(2 * time.Hour)
+	transitions = tr.Handle(miningcore.BlockUnlockedMessage{BlockMessage: miningcore.BlockMessage{PoolID: "eth", BlockHeight: 102}})
+	assert.Len(t, transitions, 1)
+	assert.Equal(t, 2*time.Hour, transitions[0].Maturity)
+
+	timing = tr.Timing("eth")
+	assert.Equal(t, 3, timing.Confirmed)
+	assert.Equal(t, (time.Hour+5*time.Minute+2*time.Hour)/2, timing.MedianConfirm)
+	assert.Equal(t, 2*time.Hour, timing.MaxConfirm)
+}
+
+func TestTrackerPendingBeyondFirstPage(t *testing.T) {
+	var mu sync.Mutex
+	pending := &miningcore.Block{BlockHeight: 1, Status: miningcore.BlockPending, Created: "2022-07-01T00:00:00Z"}
+	all := []*miningcore.Block{pending}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		size, _ := strconv.Atoi(r.URL.Query().Get("pageSize"))
+		var res []*miningcore.Block
+		if start := page * size; start < len(all) {
+			res = all[start:]
+			if len(res) > size {
+				res = res[:size]
+			}
+		}
+		json.NewEncoder(w).Encode(miningcore.BlocksRes{Meta: &miningcore.Meta{Success: true}, Result: res})
+	}))
+	defer ts.Close()
+
+	tr := New(miningcore.New(ts.URL), []string{"eth"})
+	_, err := tr.Poll(context.Background())
+	assert.NoError(t, err)
+
+	// the pending block moves to the second page while it is confirmed
+	mu.Lock()
+	pending.Status = miningcore.BlockConfirmed
+	for i := 0; i < blocks.PageSize; i++ {
+		created := time.Date(2022, 7, 2, i, 0, 0, 0, time.UTC).Format(time.RFC3339)
+		all = append([]*miningcore.Block{{BlockHeight: int64(i + 2), Status: miningcore.BlockConfirmed, Created: created}}, all...)
+	}
+	mu.Unlock()
+	transitions, err := tr.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, transitions, 1)
+	assert.Equal(t, uint64(1), transitions[0].BlockHeight)
+	assert.Equal(t, 0, tr.Timing("eth").Pending)
+}
@@ -0,0 +1,352 @@
+// Package watch monitors the workers of miners and reports when they go offline,
+// recover or lose hashrate.
+package watch
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stratumfarm/go-miningcore-client/internal/poll"
+)
+
+// EventType is the kind of a worker event.
+type EventType string
+
+const (
+	WorkerOffline      EventType = "offline"
+	WorkerRecovered    EventType = "recovered"
+	HashrateDrop       EventType = "hashratedrop"
+	ReportedDivergence EventType = "reporteddivergence"
+)
+
+// Target is a miner address on a pool.
+type Target struct {
+	PoolID  string `json:"poolId"`
+	Address string `json:"address"`
+}
+
+// Event is emitted when the state of a worker changes.
+// Baseline is the average hashrate of the worker over the tracked history.
+type Event struct {
+	Type     EventType           `json:"type"`
+	PoolID   string              `json:"poolId"`
+	Address  string              `json:"address"`
+	Worker   string              `json:"worker"`
+	Hashrate miningcore.Hashrate `json:"hashrate"`
+	Reported miningcore.Hashrate `json:"reported"`
+	Baseline miningcore.Hashrate `json:"baseline"`
+	Time     time.Time           `json:"time"`
+}
+
+// Sample is a single hashrate observation of a worker.
+type Sample struct {
+	Time     time.Time           `json:"time"`
+	Hashrate miningcore.Hashrate `json:"hashrate"`
+	Reported miningcore.Hashrate `json:"reported"`
+}
+
+// WatcherOpts are options for the watcher.
+type WatcherOpts func(*Watcher)
+
+// WithInterval sets the poll interval.
+func WithInterval(d time.Duration) WatcherOpts {
+	return func(w *Watcher) {
+		w.interval = d
+	}
+}
+
+// WithOfflineAfter sets the number of consecutive polls a worker must be missing or
+// without hashrate before it is reported offline.
+func WithOfflineAfter(polls int) WatcherOpts {
+	return func(w *Watcher) {
+		w.offlineAfter = polls
+	}
+}
+
+// WithDropThreshold sets the relative hashrate drop below the baseline that triggers a HashrateDrop event.
+func WithDropThreshold(f float64) WatcherOpts {
+	return func(w *Watcher) {
+		w.dropThreshold = f
+	}
+}
+
+// WithDivergenceThreshold sets the relative difference between reported and effective hashrate
+// that triggers a ReportedDivergence event.
+func WithDivergenceThreshold(f float64) WatcherOpts {
+	return func(w *Watcher) {
+		w.divergenceThreshold = f
+	}
+}
+
+// WithHistory sets the number of samples kept per worker.
+func WithHistory(n int) WatcherOpts {
+	return func(w *Watcher) {
+		w.history = n
+	}
+}
+
+// WithBackfill sets the sample range of the performance samples that seed the history of
+// the workers of a miner on its first poll: Hour, Day or Month. An empty mode disables it.
+func WithBackfill(mode string) WatcherOpts {
+	return func(w *Watcher) {
+		w.backfill = mode
+	}
+}
+
+// WithErrorHandler sets a function that is called with errors of failed polls in Run.
+func WithErrorHandler(fn func(error)) WatcherOpts {
+	return func(w *Watcher) {
+		w.onError = fn
+	}
+}
+
+// Watcher polls a list of miners and tracks the hashrate of their workers.
+type Watcher struct {
+	client              *miningcore.Client
+	targets             []Target
+	interval            time.Duration
+	offlineAfter        int
+	dropThreshold       float64
+	divergenceThreshold float64
+	history             int
+	backfill            string
+	onError             func(error)
+
+	mu      sync.Mutex
+	workers map[workerKey]*workerState
+	seeded  map[Target]bool
+}
+
+type workerKey struct {
+	target Target
+	worker string
+}
+
+type workerState struct {
+	samples   []Sample
+	missing   int
+	offline   bool
+	dropped   bool
+	diverging bool
+}
+
+// New creates a new watcher for the given miners.
+func New(c *miningcore.Client, targets []Target, opts ...WatcherOpts) *Watcher {
+	w := &Watcher{
+		client:              c,
+		targets:             targets,
+		interval:            time.Minute,
+		offlineAfter:        2,
+		dropThreshold:       0.3,
+		divergenceThreshold: 0.2,
+		history:             60,
+		backfill:            "Day",
+		workers:             make(map[workerKey]*workerState),
+		seeded:              make(map[Target]bool),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Run polls all targets until the context is canceled and calls fn for every event.
+func (w *Watcher) Run(ctx context.Context, fn func(Event)) error {
+	return poll.Each(ctx, w.interval, w.Poll, fn, w.onError)
+}
+
+// Poll fetches the current stats of all targets once and returns the resulting events.
+// Targets that fail to load are skipped, the first error is returned along with the events of the others.
+// The history of a target is seeded from its performance samples before its first poll, so drops are
+// detected without waiting for the history to fill up.
+func (w *Watcher) Poll(ctx context.Context) ([]Event, error) {
+	var events []Event
+	var firstErr error
+	for _, target := range w.targets {
+		if err := w.seed(ctx, target); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		miner, _, err := w.client.GetMiner(ctx, target.PoolID, target.Address)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		var workers map[string]*miningcore.WorkerPerformanceStats
+		if miner.Performance != nil {
+			workers = miner.Performance.Workers
+		}
+		events = append(events, w.observe(target, workers, time.Now())...)
+	}
+	return events, firstErr
+}
+
+// History returns the tracked samples of a worker, oldest first.
+func (w *Watcher) History(target Target, worker string) []Sample {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	s, ok := w.workers[workerKey{target, worker}]
+	if !ok {
+		return nil
+	}
+	return append([]Sample(nil), s.samples...)
+}
+
+// seed fills the history of the workers of a target from its performance samples.
+// Failed attempts are retried on the next poll.
+func (w *Watcher) seed(ctx context.Context, target Target) error {
+	w.mu.Lock()
+	done := w.seeded[target] || w.backfill == ""
+	w.mu.Unlock()
+	if done {
+		return nil
+	}
+	samples, _, err := w.client.GetMinerPerformance(ctx, target.PoolID, target.Address, map[string]string{"mode": w.backfill})
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(samples, func(i, j int) bool { return samples[i].Created < samples[j].Created })
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for _, s := range samples {
+		created, err := miningcore.ParseTime(s.Created)
+		if err != nil {
+			continue
+		}
+		for name, stats := range s.Workers {
+			if stats == nil || stats.Hashrate <= 0 {
+				continue
+			}
+			key := workerKey{target, name}
+			state, ok := w.workers[key]
+			if !ok {
+				state = &workerState{}
+				w.workers[key] = state
+			}
+			state.samples = append(state.samples, Sample{Time: created, Hashrate: stats.Hashrate, Reported: stats.ReportedHashrate})
+			if len(state.samples) > w.history {
+				state.samples = state.samples[len(state.samples)-w.history:]
+			}
+		}
+	}
+	w.seeded[target] = true
+	return nil
+}
+
+func (w *Watcher) observe(target Target, workers map[string]*miningcore.WorkerPerformanceStats, now time.Time) []Event {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var events []Event
+	for name, stats := range workers {
+		key := workerKey{target, name}
+		if _, ok := w.workers[key]; !ok {
+			w.workers[key] = &workerState{}
+		}
+		events = append(events, w.update(key, stats, now)...)
+	}
+	// workers that disappeared from the stats count as missing
+	for key, state := range w.workers {
+		if key.target != target {
+			continue
+		}
+		if _, ok := workers[key.worker]; ok {
+			continue
+		}
+		if e, ok := w.markMissing(key, state, now); ok {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func (w *Watcher) update(key workerKey, stats *miningcore.WorkerPerformanceStats, now time.Time) []Event {
+	state := w.workers[key]
+	if stats.Hashrate <= 0 {
+		if e, ok := w.markMissing(key, state, now); ok {
+			return []Event{e}
+		}
+		return nil
+	}
+
+	var events []Event
+	newEvent := func(t EventType, baseline miningcore.Hashrate) Event {
+		return Event{
+			Type:     t,
+			PoolID:   key.target.PoolID,
+			Address:  key.target.Address,
+			Worker:   key.worker,
+			Hashrate: stats.Hashrate,
+			Reported: stats.ReportedHashrate,
+			Baseline: baseline,
+			Time:     now,
+		}
+	}
+
+	baseline := average(state.samples)
+	state.missing = 0
+	if state.offline {
+		state.offline = false
+		events = append(events, newEvent(WorkerRecovered, baseline))
+	}
+
+	dropped := baseline > 0 && float64(stats.Hashrate) < float64(baseline)*(1-w.dropThreshold)
+	if dropped && !state.dropped {
+		events = append(events, newEvent(HashrateDrop, baseline))
+	}
+	state.dropped = dropped
+
+	diverging := stats.ReportedHashrate > 0 &&
+		abs(float64(stats.ReportedHashrate-stats.Hashrate))/float64(stats.ReportedHashrate) > w.divergenceThreshold
+	if diverging && !state.diverging {
+		events = append(events, newEvent(ReportedDivergence, baseline))
+	}
+	state.diverging = diverging
+
+	state.samples = append(state.samples, Sample{Time: now, Hashrate: stats.Hashrate, Reported: stats.ReportedHashrate})
+	if len(state.samples) > w.history {
+		state.samples = state.samples[len(state.samples)-w.history:]
+	}
+	return events
+}
+
+func (w *Watcher) markMissing(key workerKey, state *workerState, now time.Time) (Event, bool) {
+	state.missing++
+	if state.offline || state.missing < w.offlineAfter {
+		return Event{}, false
+	}
+	state.offline = true
+	state.dropped = false
+	state.diverging = false
+	return Event{
+		Type:     WorkerOffline,
+		PoolID:   key.target.PoolID,
+		Address:  key.target.Address,
+		Worker:   key.worker,
+		Baseline: average(state.samples),
+		Time:     now,
+	}, true
+}
+
+func average(samples []Sample) miningcore.Hashrate {
+	if len(samples) == 0 {
+		return 0
+	}
+	var sum miningcore.Hashrate
+	for _, s := range samples {
+		sum += s.Hashrate
+	}
+	return sum / miningcore.Hashrate(len(samples))
+}
+
+func abs(f float64) float64 {
+	if f < 0 {
+		return -f
+	}
+	return f
+}
@@ -0,0 +1,96 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+type minerServer struct {
+	mu      sync.Mutex
+	workers map[string]*miningcore.WorkerPerformanceStats
+	samples []*miningcore.WorkerStats
+}
+
+func (s *minerServer) set(workers map[string]*miningcore.WorkerPerformanceStats) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.workers = workers
+}
+
+func (s *minerServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if strings.HasSuffix(r.URL.Path, "/performance") {
+		json.NewEncoder(w).Encode(s.samples)
+		return
+	}
+	json.NewEncoder(w).Encode(miningcore.MinerStats{
+		Performance: &miningcore.WorkerStats{Workers: s.workers},
+	})
+}
+
+func types(events []Event) []EventType {
+	var res []EventType
+	for _, e := range events {
+		res = append(res, e.Type)
+	}
+	return res
+}
+
+func TestWatcher(t *testing.T) {
+	srv := &minerServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	target := Target{PoolID: "eth", Address: "0xabc"}
+	w := New(miningcore.New(ts.URL), []Target{target}, WithOfflineAfter(2), WithDropThreshold(0.5))
+	poll := func() []EventType {
+		events, err := w.Poll(context.Background())
+		assert.NoError(t, err)
+		return types(events)
+	}
+
+	srv.set(map[string]*miningcore.WorkerPerformanceStats{"rig1": {Hashrate: 100, ReportedHashrate: 100}})
+	assert.Empty(t, poll())
+	assert.Empty(t, poll())
+
+	srv.set(map[string]*miningcore.WorkerPerformanceStats{"rig1": {Hashrate: 40, ReportedHashrate: 100}})
+	assert.Equal(t, []EventType{HashrateDrop, ReportedDivergence}, poll())
+	assert.Empty(t, poll())
+
+	srv.set(map[string]*miningcore.WorkerPerformanceStats{})
+	assert.Empty(t, poll())
+	assert.Equal(t, []EventType{WorkerOffline}, poll())
+	assert.Empty(t, poll())
+
+	srv.set(map[string]*miningcore.WorkerPerformanceStats{"rig1": {Hashrate: 100, ReportedHashrate: 100}})
+	assert.Equal(t, []EventType{WorkerRecovered}, poll())
+	assert.Len(t, w.History(target, "rig1"), 5)
+}
+
+func TestWatcherBackfill(t *testing.T) {
+	srv := &minerServer{samples: []*miningcore.WorkerStats{
+		{Created: "2022-07-01T01:00:00Z", Workers: map[string]*miningcore.WorkerPerformanceStats{"rig1": {Hashrate: 100}}},
+		{Created: "2022-07-01T00:00:00Z", Workers: map[string]*miningcore.WorkerPerformanceStats{"rig1": {Hashrate: 100}}},
+	}}
+	srv.set(map[string]*miningcore.WorkerPerformanceStats{"rig1": {Hashrate: 40}})
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	target := Target{PoolID: "eth", Address: "0xabc"}
+	w := New(miningcore.New(ts.URL), []Target{target}, WithDropThreshold(0.5))
+	events, err := w.Poll(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []EventType{HashrateDrop}, types(events))
+	history := w.History(target, "rig1")
+	assert.Len(t, history, 3)
+	assert.True(t, history[0].Time.Before(history[1].Time))
+}
@@ -0,0 +1,287 @@
+// Package webhook posts pool notifications to webhook endpoints with retries,
+// HMAC signatures, per-pool filtering and deduplication.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+)
+
+// SignatureHeader is the header that carries the HMAC-SHA256 signature of the body.
+// The signature covers the timestamp header and the body: hex(hmac(secret, timestamp + "." + body)).
+const (
+	SignatureHeader = "X-Miningcore-Signature"
+	TimestampHeader = "X-Miningcore-Timestamp"
+)
+
+// Endpoint is a webhook target. Empty Pools or Types match everything.
+// Template is a text/template that renders the request body, it defaults to a JSON
+// object with the type, the pool id and the message. The "json" function encodes a value as JSON.
+type Endpoint struct {
+	URL      string                    `json:"url"`
+	Secret   string                    `json:"secret"`
+	Pools    []string                  `json:"pools"`
+	Types    []miningcore.WebsocketMsg `json:"types"`
+	Template string                    `json:"template"`
+	Headers  map[string]string         `json:"headers"`
+}
+
+// Event is the data the body template is executed with.
+type Event struct {
+	Type    miningcore.WebsocketMsg `json:"type"`
+	PoolID  string                  `json:"poolId"`
+	Time    time.Time               `json:"time"`
+	Message miningcore.Notification `json:"message"`
+}
+
+const defaultTemplate = `{"type":{{json .Type}},"poolId":{{json .PoolID}},"time":{{json .Time}},"message":{{json .Message}}}`
+
+// NotifierOpts are options for the notifier.
+type NotifierOpts func(*Notifier)
+
+// WithRetries sets the number of attempts per delivery and the initial backoff, which doubles after every attempt.
+func WithRetries(attempts int, backoff time.Duration) NotifierOpts {
+	return func(n *Notifier) {
+		n.attempts = attempts
+		n.backoff = backoff
+	}
+}
+
+// WithDedupWindow sets how long identical notifications are suppressed.
+func WithDedupWindow(d time.Duration) NotifierOpts {
+	return func(n *Notifier) {
+		n.dedupWindow = d
+	}
+}
+
+// WithHTTPClient sets the http client used for deliveries.
+func WithHTTPClient(c *http.Client) NotifierOpts {
+	return func(n *Notifier) {
+		n.http = c
+	}
+}
+
+// Notifier delivers notifications to webhook endpoints.
+type Notifier struct {
+	endpoints   []*endpoint
+	http        *http.Client
+	attempts    int
+	backoff     time.Duration
+	dedupWindow time.Duration
+	now         func() time.Time
+
+	mu   sync.Mutex
+	seen map[delivery]time.Time
+}
+
+// delivery identifies a notification delivered to an endpoint.
+type delivery struct {
+	endpoint int
+	key      string
+}
+
+type endpoint struct {
+	Endpoint
+	tmpl  *template.Template
+	pools map[string]bool
+	types map[miningcore.WebsocketMsg]bool
+}
+
+// New creates a notifier for the given endpoints and parses their templates.
+func New(endpoints []Endpoint, opts ...NotifierOpts) (*Notifier, error) {
+	n := &Notifier{
+		http:        &http.Client{Timeout: 10 * time.Second},
+		attempts:    3,
+		backoff:     time.Second,
+		dedupWindow: 10 * time.Minute,
+		now:         time.Now,
+		seen:        make(map[delivery]time.Time),
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+	for i, e := range endpoints {
+		text := e.Template
+		if text == "" {
+			text = defaultTemplate
+		}
+		tmpl, err := template.New(e.URL).Funcs(template.FuncMap{"json": toJSON}).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("endpoint %d: %w", i, err)
+		}
+		ep := &endpoint{Endpoint: e, tmpl: tmpl, pools: make(map[string]bool), types: make(map[miningcore.WebsocketMsg]bool)}
+		for _, p := range e.Pools {
+			ep.pools[p] = true
+		}
+		for _, t := range e.Types {
+			ep.types[t] = true
+		}
+		n.endpoints = append(n.endpoints, ep)
+	}
+	return n, nil
+}
+
+// Notify delivers a notification to all matching endpoints. Endpoints that already received
+// the notification within the dedup window or are receiving it from a concurrent call are
+// skipped, failed deliveries are not recorded and go out again when the notification is
+// repeated. The first delivery error is returned.
+func (n *Notifier) Notify(ctx context.Context, msg miningcore.Notification) error {
+	key := dedupKey(msg)
+	ev := Event{Type: msg.MessageType(), PoolID: miningcore.PoolID(msg), Time: n.now().UTC(), Message: msg}
+
+	var wg sync.WaitGroup
+	errs := make([]error, len(n.endpoints))
+	for i, e := range n.endpoints {
+		if !e.matches(ev) || !n.reserve(delivery{i, key}) {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+			errs[i] = n.deliver(ctx, e, ev)
+			n.finish(delivery{i, key}, errs[i] == nil)
+		}(i, e)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *endpoint) matches(ev Event) bool {
+	if len(e.pools) > 0 && !e.pools[ev.PoolID] {
+		return false
+	}
+	if len(e.types) > 0 && !e.types[ev.Type] {
+		return false
+	}
+	return true
+}
+
+// dedupKey returns the hash of a notification, or an empty key if it can't be encoded.
+func dedupKey(msg miningcore.Notification) string {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(append([]byte(msg.MessageType()+":"), data...))
+	return hex.EncodeToString(sum[:])
+}
+
+// reserve claims a delivery unless it succeeded within the dedup window or is in flight.
+// Claimed deliveries are in the seen map with a zero time until they finish.
+func (n *Notifier) reserve(d delivery) bool {
+	if d.key == "" {
+		return true
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	now := n.now()
+	for k, t := range n.seen {
+		if !t.IsZero() && now.Sub(t) > n.dedupWindow {
+			delete(n.seen, k)
+		}
+	}
+	if _, ok := n.seen[d]; ok {
+		return false
+	}
+	n.seen[d] = time.Time{}
+	return true
+}
+
+// finish records a successful delivery and releases a failed one, so it can be retried.
+func (n *Notifier) finish(d delivery, ok bool) {
+	if d.key == "" {
+		return
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if ok {
+		n.seen[d] = n.now()
+	} else {
+		delete(n.seen, d)
+	}
+}
+
+func (n *Notifier) deliver(ctx context.Context, e *endpoint, ev Event) error {
+	var body bytes.Buffer
+	if err := e.tmpl.Execute(&body, ev); err != nil {
+		return err
+	}
+
+	backoff := n.backoff
+	var err error
+	for attempt := 0; attempt < n.attempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+		var retry bool
+		retry, err = n.post(ctx, e, body.Bytes())
+		if err == nil || !retry {
+			return err
+		}
+	}
+	return err
+}
+
+// post sends the body once and reports whether a failed delivery should be retried.
+func (n *Notifier) post(ctx context.Context, e *endpoint, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.Headers {
+		req.Header.Set(k, v)
+	}
+	if e.Secret != "" {
+		ts := strconv.FormatInt(n.now().Unix(), 10)
+		req.Header.Set(TimestampHeader, ts)
+		req.Header.Set(SignatureHeader, "sha256="+Sign(e.Secret, ts, body))
+	}
+
+	resp, err := n.http.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		return false, nil
+	}
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("webhook %s returned %s", e.URL, resp.Status)
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of a delivery.
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte{'.'})
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func toJSON(v any) (string, error) {
+	data, err := json.Marshal(v)
+	return string(data), err
+}
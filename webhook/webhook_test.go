@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stratumfarm/go-miningcore-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotify(t *testing.T) {
+	var calls int32
+	bodies := make(chan *http.Request, 10)
+	payloads := make(chan string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		data, _ := io.ReadAll(r.Body)
+		bodies <- r
+		payloads <- string(data)
+	}))
+	defer srv.Close()
+
+	n, err := New([]Endpoint{
+		{URL: srv.URL, Secret: "s3cret", Pools: []string{"eth"}, Template: `{"text":"block {{.Message.BlockHeight}} on {{.PoolID}}"}`},
+		{URL: srv.URL + "/payments", Types: []miningcore.WebsocketMsg{miningcore.WsPayment}},
+	}, WithRetries(3, time.Millisecond))
+	assert.NoError(t, err)
+	now := time.Unix(1657000000, 0)
+	n.now = func() time.Time { return now }
+
+	msg := &miningcore.BlockFoundMessage{BlockMessage: miningcore.BlockMessage{PoolID: "eth", BlockHeight: 42}}
+	assert.NoError(t, n.Notify(context.Background(), msg))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	req := <-bodies
+	body := <-payloads
+	assert.Equal(t, `{"text":"block 42 on eth"}`, body)
+	assert.Equal(t, "1657000000", req.Header.Get(TimestampHeader))
+	assert.Equal(t, "sha256="+Sign("s3cret", "1657000000", []byte(body)), req.Header.Get(SignatureHeader))
+
+	// duplicates and other pools are dropped
+	assert.NoError(t, n.Notify(context.Background(), msg))
+	assert.NoError(t, n.Notify(context.Background(), &miningcore.BlockFoundMessage{BlockMessage: miningcore.BlockMessage{PoolID: "btc"}}))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	assert.NoError(t, n.Notify(context.Background(), &miningcore.PaymentMessage{PoolID: "btc", Amount: 1.5}))
+	req = <-bodies
+	assert.Equal(t, "/payments", req.URL.Path)
+	assert.JSONEq(t, `{"type":"payment","poolId":"btc","time":"2022-07-05T05:46:40Z","message":{"poolId":"btc","symbol":"","txFee":0,"txIds":null,"txExplorerLinks":null,"recipientsCount":0,"amount":1.5,"error":null}}`, <-payloads)
+}
+
+func TestNotifyNoRetryOnClientError(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer srv.Close()
+
+	n, err := New([]Endpoint{{URL: srv.URL}}, WithRetries(3, time.Millisecond))
+	assert.NoError(t, err)
+	assert.Error(t, n.Notify(context.Background(), &miningcore.HashRateUpdateMessage{PoolID: "eth"}))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestNotifyDedupPerEndpoint(t *testing.T) {
+	var okCalls, failCalls int32
+	fail := int32(1)
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&okCalls, 1)
+	}))
+	defer ok.Close()
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&failCalls, 1)
+		if atomic.LoadInt32(&fail) == 1 {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer failing.Close()
+
+	n, err := New([]Endpoint{
+		{URL: ok.URL, Pools: []string{"eth"}},
+		{URL: failing.URL, Pools: []string{"eth"}},
+	}, WithRetries(1, time.Millisecond))
+	assert.NoError(t, err)
+
+	// value messages match the pool filter
+	msg := miningcore.PaymentMessage{PoolID: "eth", Amount: 1}
+	assert.Error(t, n.Notify(context.Background(), msg))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&okCalls))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&failCalls))
+
+	// only the failed endpoint gets the repeated notification
+	atomic.StoreInt32(&fail, 0)
+	assert.NoError(t, n.Notify(context.Background(), msg))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&okCalls))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&failCalls))
+
+	assert.NoError(t, n.Notify(context.Background(), msg))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&failCalls))
+}
+
+func TestNotifyConcurrentDuplicates(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+	}))
+	defer srv.Close()
+
+	n, err := New([]Endpoint{{URL: srv.URL}})
+	assert.NoError(t, err)
+	msg := &miningcore.BlockFoundMessage{BlockMessage: miningcore.BlockMessage{PoolID: "eth", BlockHeight: 42}}
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, n.Notify(context.Background(), msg))
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestNotifyPaymentError(t *testing.T) {
+	payloads := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		payloads <- string(data)
+	}))
+	defer srv.Close()
+
+	n, err := New([]Endpoint{{URL: srv.URL, Template: `{{json .Message}}`}})
+	assert.NoError(t, err)
+	assert.NoError(t, n.Notify(context.Background(), &miningcore.PaymentMessage{PoolID: "eth", Error: errors.New("insufficient funds")}))
+	assert.Contains(t, <-payloads, `"error":"insufficient funds"`)
+}